@@ -53,6 +53,9 @@ func NewGRPCServer(cfg Config, deps Dependencies) *GRPCServer {
 		grpc.ChainUnaryInterceptor(
 			logging.UnaryServerInterceptor(InterceptorLogger(deps.Log)),
 		),
+		grpc.ChainStreamInterceptor(
+			logging.StreamServerInterceptor(InterceptorLogger(deps.Log)),
+		),
 	)
 	return &GRPCServer{
 		Server: srv,
@@ -0,0 +1,73 @@
+package metricsserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type Config struct {
+	Address string
+}
+
+type Dependencies struct {
+	Registry *prometheus.Registry
+	Log      *logrus.Logger
+}
+
+// MetricsServer serves the metrics of the registry on /metrics.
+type MetricsServer struct {
+	cfg  Config
+	deps Dependencies
+	log  *logrus.Entry
+}
+
+func New(cfg Config, deps Dependencies) *MetricsServer {
+	return &MetricsServer{
+		cfg:  cfg,
+		deps: deps,
+		log:  deps.Log.WithField("component", "metrics"),
+	}
+}
+
+// Run serves until ctx is done.
+func (s *MetricsServer) Run(ctx context.Context) error {
+	li, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(s.deps.Registry, promhttp.HandlerOpts{}))
+	srv := &http.Server{Handler: mux}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.log.WithField("address", li.Addr().String()).Info("metrics server started")
+		errCh <- srv.Serve(li)
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("shutdown: %w", err)
+		}
+		return nil
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var errInvalidPrecondition = errors.New("invalid precondition")
+
+// precondition is a conditional request expressed with the If-Match and
+// If-None-Match headers, the key version is used as an entity tag.
+type precondition struct {
+	// version is the version the key must have, zero means any.
+	version uint64
+	// absent requires the key to not exist (If-None-Match: *).
+	absent bool
+}
+
+func parsePrecondition(c *gin.Context) (precondition, error) {
+	var (
+		cond        precondition
+		ifMatch     = strings.TrimSpace(c.GetHeader("If-Match"))
+		ifNoneMatch = strings.TrimSpace(c.GetHeader("If-None-Match"))
+	)
+
+	if ifMatch != "" && ifNoneMatch != "" {
+		return precondition{}, errInvalidPrecondition
+	}
+
+	if ifNoneMatch != "" {
+		if ifNoneMatch != "*" {
+			return precondition{}, errInvalidPrecondition
+		}
+		cond.absent = true
+	}
+
+	if ifMatch != "" {
+		version, err := parseETag(ifMatch)
+		if err != nil {
+			return precondition{}, errInvalidPrecondition
+		}
+		cond.version = version
+	}
+
+	return cond, nil
+}
+
+func formatETag(version uint64) string {
+	return strconv.Quote(strconv.FormatUint(version, 10))
+}
+
+func parseETag(tag string) (uint64, error) {
+	tag = strings.TrimPrefix(tag, "W/")
+	if unquoted, err := strconv.Unquote(tag); err == nil {
+		tag = unquoted
+	}
+
+	version, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if version == 0 {
+		return 0, errInvalidPrecondition
+	}
+	return version, nil
+}
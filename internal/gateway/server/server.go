@@ -8,6 +8,7 @@ import (
 	"kvstore/internal/storeservice/client"
 	"kvstore/internal/storeservice/manager"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -36,14 +37,21 @@ type Server struct {
 
 	mc  *serverMetricsCollector
 	log *logrus.Entry
+	// streams is cancelled on shutdown to end the watch streams, which
+	// would otherwise hold the shutdown until its timeout.
+	streams     context.Context
+	stopStreams context.CancelFunc
 }
 
 func NewServer(cfg Config, deps Dependencies) *Server {
+	streams, stopStreams := context.WithCancel(context.Background())
 	return &Server{
-		cfg:  cfg,
-		deps: deps,
-		mc:   newMetricColletor(),
-		log:  deps.Log.WithField("component", "server"),
+		cfg:         cfg,
+		deps:        deps,
+		mc:          newMetricColletor(),
+		log:         deps.Log.WithField("component", "server"),
+		streams:     streams,
+		stopStreams: stopStreams,
 	}
 }
 
@@ -58,6 +66,13 @@ func (s *Server) Run(ctx context.Context) error {
 	router.PUT("/:key", s.setHandler)
 	router.DELETE("/:key", s.deleteHandler)
 	router.GET("/", s.scanHandler)
+	router.DELETE("/", s.bulkDeleteHandler)
+	router.POST("/_batch", s.batchHandler)
+	// The endpoints besides the keys have two path segments, so they
+	// never hide a key.
+	router.GET("/_api/watch", s.watchHandler)
+	router.GET("/_api/count", s.countHandler)
+	router.GET("/_api/stats", s.statsHandler)
 	router.GET("/metrics", gin.WrapH(promhttp.HandlerFor(s.deps.Registry, promhttp.HandlerOpts{})))
 
 	var (
@@ -66,6 +81,7 @@ func (s *Server) Run(ctx context.Context) error {
 			Handler: router,
 		}
 	)
+	srv.RegisterOnShutdown(s.stopStreams)
 
 	serverClosed := make(chan struct{})
 	go func() {
@@ -95,16 +111,18 @@ func (s *Server) Run(ctx context.Context) error {
 
 func (s *Server) getHandler(c *gin.Context) {
 	key := c.Param("key")
-	value, err := s.deps.StoreClient.Get(c, key)
+	res, err := s.deps.StoreClient.Get(c, key)
 	if s.replyError(c, err) {
 		return
 	}
 
 	resp := GetResponse{
-		Key:   key,
-		Value: string(value),
+		Key:     key,
+		Value:   res.Value,
+		Version: res.Version,
 	}
 
+	c.Header("ETag", formatETag(res.Version))
 	c.JSON(http.StatusOK, &resp)
 }
 
@@ -115,13 +133,40 @@ func (s *Server) setHandler(c *gin.Context) {
 		return
 	}
 
+	var ttl time.Duration
+	if t := c.Query("ttl"); t != "" {
+		var err error
+		ttl, err = time.ParseDuration(t)
+		if err != nil || ttl < 0 {
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid ttl"})
+			return
+		}
+	}
+
+	cond, err := parsePrecondition(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, &ErrorResponse{Message: err.Error()})
+		return
+	}
+	if (cond.absent || cond.version != 0) && ttl != 0 {
+		c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "ttl is not supported by conditional writes"})
+		return
+	}
+
 	val, err := io.ReadAll(c.Request.Body)
 	if s.replyError(c, err) {
 		return
 	}
 
-	err = s.deps.StoreClient.Put(c, key, val)
-	if s.replyError(c, err) {
+	switch {
+	case cond.absent:
+		err = s.deps.StoreClient.PutIfAbsent(c, key, val)
+	case cond.version != 0:
+		err = s.deps.StoreClient.PutIfVersion(c, key, val, cond.version)
+	default:
+		err = s.deps.StoreClient.Put(c, key, val, ttl)
+	}
+	if s.replyPreconditionError(c, err) {
 		return
 	}
 
@@ -129,28 +174,159 @@ func (s *Server) setHandler(c *gin.Context) {
 }
 
 func (s *Server) deleteHandler(c *gin.Context) {
-	_ = c.Param("key")
-	// TODO: GRPC request
+	key := c.Param("key")
+
+	cond, err := parsePrecondition(c)
+	if err != nil || cond.absent {
+		c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid precondition"})
+		return
+	}
+
+	err = s.deps.StoreClient.DeleteIfVersion(c, key, cond.version)
+	if s.replyPreconditionError(c, err) {
+		return
+	}
 
 	c.Status(http.StatusOK)
 }
 
 func (s *Server) scanHandler(c *gin.Context) {
-	// var (
-	// 	limit  int
-	// 	err    error
-	// 	prefix = c.Query("prefix")
-	// )
-
-	// if l := c.Query("limit"); l != "" {
-	// 	limit, err = strconv.Atoi(l)
-	// 	if s.replyError(c, err) {
-	// 		return
-	// 	}
-	// }
-
-	// TODO: GRPC request
-	// c.JSON(http.StatusOK, &res)
+	var (
+		err  error
+		opts = manager.ScanOptions{
+			Prefix: c.Query("prefix"),
+			Start:  c.Query("start"),
+			End:    c.Query("end"),
+			Cursor: c.Query("cursor"),
+		}
+	)
+
+	if l := c.Query("limit"); l != "" {
+		opts.Limit, err = strconv.Atoi(l)
+		if err != nil || opts.Limit < 0 {
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid limit"})
+			return
+		}
+	}
+
+	if r := c.Query("reverse"); r != "" {
+		opts.Reverse, err = strconv.ParseBool(r)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid reverse"})
+			return
+		}
+	}
+
+	res, err := s.deps.StoreClient.Scan(c, opts)
+	if s.replyError(c, err) {
+		return
+	}
+
+	resp := ScanResponse{
+		List:       make([]KeyValue, 0, len(res.List)),
+		NextCursor: res.NextCursor,
+	}
+	for _, kv := range res.List {
+		resp.List = append(resp.List, KeyValue{
+			Key:   kv.Key,
+			Value: kv.Value,
+		})
+	}
+
+	c.JSON(http.StatusOK, &resp)
+}
+
+// bulkDeleteHandler deletes the keys with the prefix or in the range of
+// start and end. Deleting every key needs all=true.
+func (s *Server) bulkDeleteHandler(c *gin.Context) {
+	var (
+		err    error
+		opts   manager.DeleteOptions
+		prefix = c.Query("prefix")
+		start  = c.Query("start")
+		end    = c.Query("end")
+	)
+
+	if prefix != "" && (start != "" || end != "") {
+		c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "prefix and range are mutually exclusive"})
+		return
+	}
+
+	if d := c.Query("dry_run"); d != "" {
+		opts.DryRun, err = strconv.ParseBool(d)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid dry_run"})
+			return
+		}
+	}
+
+	if a := c.Query("all"); a != "" {
+		opts.All, err = strconv.ParseBool(a)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid all"})
+			return
+		}
+	}
+
+	var count int64
+	if start != "" || end != "" {
+		count, err = s.deps.StoreClient.DeleteRange(c, start, end, opts)
+	} else {
+		count, err = s.deps.StoreClient.DeletePrefix(c, prefix, opts)
+	}
+	if s.replyError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, &BulkDeleteResponse{
+		Count:  count,
+		DryRun: opts.DryRun,
+	})
+}
+
+func (s *Server) batchHandler(c *gin.Context) {
+	var req BatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	ops := make([]manager.Op, 0, len(req.Ops))
+	for _, op := range req.Ops {
+		if len(op.Key) == 0 {
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "empty key"})
+			return
+		}
+
+		o := manager.Op{Key: []byte(op.Key)}
+		switch op.Op {
+		case "set":
+			o.Type = manager.OpSet
+			o.Value = []byte(op.Value)
+		case "delete":
+			o.Type = manager.OpDelete
+		default:
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "unknown op " + op.Op})
+			return
+		}
+
+		if op.TTL != "" {
+			ttl, err := time.ParseDuration(op.TTL)
+			if err != nil || ttl < 0 {
+				c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid ttl"})
+				return
+			}
+			o.TTL = ttl
+		}
+		ops = append(ops, o)
+	}
+
+	err := s.deps.StoreClient.Batch(c, ops)
+	if s.replyError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusOK)
 }
 
 func (s *Server) replyError(c *gin.Context, err error) bool {
@@ -164,8 +340,23 @@ func (s *Server) replyError(c *gin.Context, err error) bool {
 	switch {
 	case errors.Is(err, manager.ErrNotFound):
 		code = http.StatusNotFound
+	case errors.Is(err, manager.ErrConflict):
+		code = http.StatusConflict
+	case errors.Is(err, client.ErrInvalidArgument):
+		code = http.StatusBadRequest
 	}
 
 	c.JSON(code, &resp)
 	return true
 }
+
+// replyPreconditionError replies 412 when a conditional request failed
+// because of a version mismatch.
+func (s *Server) replyPreconditionError(c *gin.Context, err error) bool {
+	if errors.Is(err, manager.ErrConflict) {
+		c.JSON(http.StatusPreconditionFailed, &ErrorResponse{Message: err.Error()})
+		return true
+	}
+
+	return s.replyError(c, err)
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (s *Server) countHandler(c *gin.Context) {
+	prefix := c.Query("prefix")
+	count, err := s.deps.StoreClient.Count(c, prefix)
+	if s.replyError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, &CountResponse{
+		Prefix: prefix,
+		Count:  count,
+	})
+}
+
+func (s *Server) statsHandler(c *gin.Context) {
+	stats, err := s.deps.StoreClient.Stats(c)
+	if s.replyError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, &StatsResponse{
+		Keys:         stats.Keys,
+		LogicalBytes: stats.LogicalBytes,
+		DiskBytes:    stats.DiskBytes,
+		LSMBytes:     stats.LSMBytes,
+		VlogBytes:    stats.VlogBytes,
+	})
+}
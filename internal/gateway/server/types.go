@@ -5,6 +5,64 @@ type ErrorResponse struct {
 }
 
 type GetResponse struct {
+	Key     string
+	Value   string
+	Version uint64
+}
+
+type KeyValue struct {
+	Key   string
+	Value string
+}
+
+type ScanResponse struct {
+	List []KeyValue
+	// NextCursor is the cursor parameter fetching the next page, it is
+	// empty on the last page.
+	NextCursor string
+}
+
+// BulkDeleteResponse tells how many keys were deleted, or would be with
+// DryRun.
+type BulkDeleteResponse struct {
+	Count  int64
+	DryRun bool
+}
+
+type BatchOp struct {
+	// Op is either "set" or "delete".
+	Op    string
 	Key   string
 	Value string
+	// TTL is a duration string such as "30s", empty means no expiration.
+	TTL string
+}
+
+type BatchRequest struct {
+	Ops []BatchOp
+}
+
+// WatchEvent is the data of a server-sent event of /_api/watch, the event
+// name is its Type and the event id its Version.
+type WatchEvent struct {
+	// Type is either "put" or "delete".
+	Type    string
+	Key     string
+	Value   string
+	Version uint64
+}
+
+type CountResponse struct {
+	Prefix string
+	Count  int64
+}
+
+// StatsResponse sizes are in bytes, LSMBytes and VlogBytes are only
+// reported by the badger backend.
+type StatsResponse struct {
+	Keys         int64
+	LogicalBytes int64
+	DiskBytes    int64
+	LSMBytes     int64
+	VlogBytes    int64
 }
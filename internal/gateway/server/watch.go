@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/manager"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// watchHandler streams the changes of the keys with the prefix as
+// server-sent events. A reconnecting client resumes from the id of the
+// last event it got, sent back in the Last-Event-ID header.
+func (s *Server) watchHandler(c *gin.Context) {
+	from := c.Query("from")
+	if from == "" {
+		from = c.GetHeader("Last-Event-ID")
+	}
+
+	var (
+		version uint64
+		err     error
+	)
+	if from != "" {
+		version, err = strconv.ParseUint(from, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, &ErrorResponse{Message: "invalid from"})
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	stop := context.AfterFunc(s.streams, cancel)
+	defer stop()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Content-Type", sse.ContentType)
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	err = s.deps.StoreClient.Watch(ctx, c.Query("prefix"), version, func(e manager.Event) error {
+		event := WatchEvent{
+			Type:    "put",
+			Key:     e.Key,
+			Value:   e.Value,
+			Version: e.Version,
+		}
+		if e.Type == manager.EventDelete {
+			event.Type = "delete"
+		}
+
+		c.Render(-1, sse.Event{
+			Id:    strconv.FormatUint(e.Version, 10),
+			Event: event.Type,
+			Data:  event,
+		})
+		c.Writer.Flush()
+		return nil
+	})
+	if err == nil || errors.Is(err, context.Canceled) || ctx.Err() != nil {
+		return
+	}
+
+	// The status is sent already, the error goes to the stream.
+	s.log.WithError(err).Warn("watch failed")
+	c.Render(-1, sse.Event{
+		Event: "error",
+		Data:  ErrorResponse{Message: err.Error()},
+	})
+	c.Writer.Flush()
+}
@@ -0,0 +1,95 @@
+package storeservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/bench"
+	"kvstore/internal/storeservice/client"
+	"kvstore/internal/storeservice/manager"
+	"slices"
+	"time"
+)
+
+// clientTarget runs the benchmark workloads over gRPC.
+type clientTarget struct {
+	cl *client.Client
+}
+
+func (t clientTarget) Set(ctx context.Context, key string, value []byte) error {
+	return t.cl.Put(ctx, key, value, 0)
+}
+
+func (t clientTarget) Get(ctx context.Context, key string) (bool, error) {
+	_, err := t.cl.Get(ctx, key)
+	if errors.Is(err, manager.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (t clientTarget) Scan(ctx context.Context, prefix string, limit int) (int, error) {
+	res, err := t.cl.Scan(ctx, manager.ScanOptions{Prefix: prefix, Limit: limit})
+	return len(res.List), err
+}
+
+// runWorkloads runs every workload with every value size and number of
+// workers for d and prints a line of results for each run. The keys
+// written are deleted at the end.
+func runWorkloads(ctx context.Context, cl *client.Client, workloads []bench.Workload, cfg bench.Config,
+	sizes, workers []int, d time.Duration) (err error) {
+	target := clientTarget{cl: cl}
+	defer func() {
+		// The keys are deleted even when the benchmark is interrupted.
+		_, derr := cl.DeletePrefix(context.WithoutCancel(ctx), cfg.Prefix, manager.DeleteOptions{})
+		if err == nil && derr != nil {
+			err = fmt.Errorf("delete benchmark keys: %w", derr)
+		}
+	}()
+
+	fmt.Printf("%-12s %8s %8s %12s %10s %10s %10s %10s %8s\n",
+		"WORKLOAD", "VALUE", "WORKERS", "OPS/S", "P50", "P90", "P99", "MAX", "ERRORS")
+	for _, size := range sizes {
+		cfg := cfg
+		cfg.ValueSize = size
+		cfg.Workers = slices.Max(workers)
+
+		preloaded := false
+		for _, wl := range workloads {
+			if wl.Preload && !preloaded {
+				if err := bench.Preload(ctx, target, cfg); err != nil {
+					return fmt.Errorf("preload: %w", err)
+				}
+				preloaded = true
+			}
+
+			for _, n := range workers {
+				cfg := cfg
+				cfg.Workers = n
+
+				res := bench.RunFor(ctx, target, wl, cfg, d)
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				fmt.Printf("%-12s %8d %8d %12.0f %10s %10s %10s %10s %8d\n",
+					wl.Name, size, n, res.Throughput(),
+					round(res.Percentile(50)), round(res.Percentile(90)),
+					round(res.Percentile(99)), round(res.Percentile(100)), res.Errors)
+				if res.Err != nil {
+					fmt.Printf("%-12s first error: %v\n", "", res.Err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// round keeps three significant digits of the latency.
+func round(d time.Duration) time.Duration {
+	for unit := time.Nanosecond; unit < time.Second; unit *= 10 {
+		if d < 1000*unit {
+			return d.Round(unit)
+		}
+	}
+	return d.Round(time.Millisecond)
+}
@@ -0,0 +1,294 @@
+// Package bench defines the standard workloads the storage backends are
+// compared with. The same workloads run in the go benchmarks of every
+// kv.Store and against a live store over gRPC.
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Defaults of the workload dimensions.
+var (
+	ValueSizes  = []int{16, 256, 4096}
+	Parallelism = []int{1, 4, 16}
+)
+
+const (
+	DefaultPrefix = "bench/"
+	DefaultKeys   = 10000
+	// scanRows is the number of keys sharing the prefix a scan reads.
+	scanRows = 100
+)
+
+// Target is what the workloads run against.
+type Target interface {
+	Set(ctx context.Context, key string, value []byte) error
+	// Get reports whether the key was found, a missing key is not an
+	// error.
+	Get(ctx context.Context, key string) (bool, error)
+	// Scan reads up to limit keys with the prefix and returns their
+	// number.
+	Scan(ctx context.Context, prefix string, limit int) (int, error)
+}
+
+type Config struct {
+	// Prefix starts every key the workloads write.
+	Prefix string
+	// Keys is the number of keys preloaded and read.
+	Keys      int
+	ValueSize int
+	// Workers is the number of goroutines running the operations.
+	Workers int
+}
+
+// worker is the state of one goroutine of a run.
+type worker struct {
+	cfg   Config
+	r     *rand.Rand
+	value []byte
+	// seq is shared by the workers of a run.
+	seq *atomic.Int64
+}
+
+func (w *worker) key(i int) string {
+	return fmt.Sprintf("%s%012d", w.cfg.Prefix, i)
+}
+
+func (w *worker) randomKey() string {
+	return w.key(w.r.Intn(w.cfg.Keys))
+}
+
+type Workload struct {
+	Name string
+	// Preload tells the workload reads the keyspace, which has to be
+	// written with Preload first.
+	Preload bool
+	op      func(ctx context.Context, t Target, w *worker) error
+}
+
+// Workloads are the standard workloads.
+var Workloads = []Workload{
+	{Name: "set-seq", op: func(ctx context.Context, t Target, w *worker) error {
+		return t.Set(ctx, w.key(int(w.seq.Add(1))), w.value)
+	}},
+	{Name: "set-rand", op: func(ctx context.Context, t Target, w *worker) error {
+		return t.Set(ctx, w.randomKey(), w.value)
+	}},
+	{Name: "get-hit", Preload: true, op: func(ctx context.Context, t Target, w *worker) error {
+		return getHit(ctx, t, w)
+	}},
+	{Name: "get-miss", Preload: true, op: func(ctx context.Context, t Target, w *worker) error {
+		key := fmt.Sprintf("%smiss/%012d", w.cfg.Prefix, w.r.Intn(w.cfg.Keys))
+		found, err := t.Get(ctx, key)
+		if err == nil && found {
+			err = fmt.Errorf("missing key %s found", key)
+		}
+		return err
+	}},
+	{Name: "scan", Preload: true, op: func(ctx context.Context, t Target, w *worker) error {
+		// Dropping the last two digits leaves the prefix of 100 keys.
+		key := w.randomKey()
+		_, err := t.Scan(ctx, key[:len(key)-2], scanRows)
+		return err
+	}},
+	mixed(90),
+	mixed(50),
+}
+
+// mixed reads a preloaded key in reads percent of the operations and
+// writes one in the rest.
+func mixed(reads int) Workload {
+	return Workload{
+		Name:    fmt.Sprintf("mixed-%d-%d", reads, 100-reads),
+		Preload: true,
+		op: func(ctx context.Context, t Target, w *worker) error {
+			if w.r.Intn(100) < reads {
+				return getHit(ctx, t, w)
+			}
+			return t.Set(ctx, w.randomKey(), w.value)
+		},
+	}
+}
+
+func getHit(ctx context.Context, t Target, w *worker) error {
+	key := w.randomKey()
+	found, err := t.Get(ctx, key)
+	if err == nil && !found {
+		err = fmt.Errorf("preloaded key %s not found", key)
+	}
+	return err
+}
+
+// Find returns the workload with the name.
+func Find(name string) (Workload, error) {
+	for _, w := range Workloads {
+		if w.Name == name {
+			return w, nil
+		}
+	}
+	return Workload{}, fmt.Errorf("unknown workload %q", name)
+}
+
+// Preload writes the keyspace of the workloads reading it.
+func Preload(ctx context.Context, t Target, cfg Config) error {
+	res := run(ctx, t, cfg, func(ctx context.Context, t Target, w *worker) error {
+		return t.Set(ctx, w.key(int(w.seq.Add(1)-1)), w.value)
+	}, counter(cfg.Keys), true)
+	if res.Err == nil {
+		// A cancelled preload stops without errors of its own.
+		return ctx.Err()
+	}
+	return res.Err
+}
+
+// Result is the outcome of a run.
+type Result struct {
+	Ops    int64
+	Errors int64
+	// Err is the first error of the run.
+	Err     error
+	Elapsed time.Duration
+	// latencies of the successful operations, sorted.
+	latencies []time.Duration
+}
+
+// Throughput is the number of operations per second.
+func (r Result) Throughput() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Ops) / r.Elapsed.Seconds()
+}
+
+// Percentile returns the latency p percent of the operations were faster
+// than, p is in [0, 100].
+func (r Result) Percentile(p float64) time.Duration {
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	i := int(p / 100 * float64(len(r.latencies)-1))
+	return r.latencies[i]
+}
+
+// RunN runs n operations of the workload, the first error stops the
+// run.
+func RunN(ctx context.Context, t Target, wl Workload, cfg Config, n int) Result {
+	return run(ctx, t, cfg, wl.op, counter(n), true)
+}
+
+// RunFor runs the workload until d passes or ctx is done, errors are
+// counted and the run goes on.
+func RunFor(ctx context.Context, t Target, wl Workload, cfg Config, d time.Duration) Result {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	return run(ctx, t, cfg, wl.op, func() bool { return true }, false)
+}
+
+// counter allows n operations.
+func counter(n int) func() bool {
+	var taken atomic.Int64
+	return func() bool { return taken.Add(1) <= int64(n) }
+}
+
+// run calls op in cfg.Workers goroutines until ctx is done or next
+// returns false and measures every call. Calls failing after ctx is done
+// were cut short by the end of the run and are not counted.
+func run(ctx context.Context, t Target, cfg Config,
+	op func(context.Context, Target, *worker) error, next func() bool, stopOnError bool) Result {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		seq   atomic.Int64
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		res   Result
+		start = time.Now()
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		res.Errors++
+		if res.Err == nil {
+			res.Err = err
+		}
+		if stopOnError {
+			cancel()
+		}
+	}
+
+	for i := 0; i < max(cfg.Workers, 1); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			w := &worker{
+				cfg:   cfg,
+				r:     rand.New(rand.NewSource(int64(i))),
+				value: make([]byte, cfg.ValueSize),
+				seq:   &seq,
+			}
+			w.r.Read(w.value)
+
+			var latencies []time.Duration
+			for ctx.Err() == nil && next() {
+				opStart := time.Now()
+				if err := op(ctx, t, w); err != nil {
+					if ctx.Err() == nil {
+						fail(err)
+					}
+					continue
+				}
+				latencies = append(latencies, time.Since(opStart))
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			res.Ops += int64(len(latencies))
+			res.latencies = append(res.latencies, latencies...)
+		}(i)
+	}
+	wg.Wait()
+
+	res.Elapsed = time.Since(start)
+	sort.Slice(res.latencies, func(i, j int) bool { return res.latencies[i] < res.latencies[j] })
+	return res
+}
+
+// storeTarget runs the workloads on a kv.Store.
+type storeTarget struct {
+	s kv.Store
+}
+
+func StoreTarget(s kv.Store) Target {
+	return storeTarget{s: s}
+}
+
+func (t storeTarget) Set(ctx context.Context, key string, value []byte) error {
+	return t.s.Set(ctx, kv.Key(key), value)
+}
+
+func (t storeTarget) Get(ctx context.Context, key string) (bool, error) {
+	_, err := t.s.Get(ctx, kv.Key(key))
+	if errors.Is(err, kv.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (t storeTarget) Scan(ctx context.Context, prefix string, limit int) (int, error) {
+	var rows int
+	err := t.s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key(prefix), Limit: limit}, func(kv.Key, kv.Value) error {
+		rows++
+		return nil
+	})
+	return rows, err
+}
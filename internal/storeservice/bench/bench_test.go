@@ -0,0 +1,67 @@
+package bench
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/mapkv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloads(t *testing.T) {
+	ctx := context.Background()
+	s := mapkv.NewStore()
+	defer s.Close()
+
+	target := StoreTarget(s)
+	cfg := Config{Prefix: DefaultPrefix, Keys: 1000, ValueSize: 64, Workers: 4}
+	require.NoError(t, Preload(ctx, target, cfg))
+
+	n, err := s.Count(ctx, kv.Key(DefaultPrefix))
+	require.NoError(t, err)
+	require.Equal(t, int64(cfg.Keys), n)
+
+	for _, wl := range Workloads {
+		t.Run(wl.Name, func(t *testing.T) {
+			res := RunN(ctx, target, wl, cfg, 500)
+			require.NoError(t, res.Err)
+			require.Equal(t, int64(500), res.Ops)
+			require.LessOrEqual(t, res.Percentile(50), res.Percentile(99))
+			require.LessOrEqual(t, res.Percentile(99), res.Percentile(100))
+			require.Positive(t, res.Throughput())
+		})
+	}
+}
+
+func TestRunFor(t *testing.T) {
+	s := mapkv.NewStore()
+	defer s.Close()
+
+	wl, err := Find("set-rand")
+	require.NoError(t, err)
+	_, err = Find("nope")
+	require.Error(t, err)
+
+	res := RunFor(context.Background(), StoreTarget(s), wl, Config{Prefix: DefaultPrefix, Keys: 100, Workers: 2}, 50*time.Millisecond)
+	require.NoError(t, res.Err)
+	require.Zero(t, res.Errors)
+	require.Positive(t, res.Ops)
+	require.GreaterOrEqual(t, res.Elapsed, 50*time.Millisecond)
+}
+
+// TestRunNStops checks that the first error stops the run.
+func TestRunNStops(t *testing.T) {
+	s := mapkv.NewStore()
+	defer s.Close()
+
+	// Nothing is preloaded, so the first read fails.
+	wl, err := Find("get-hit")
+	require.NoError(t, err)
+
+	res := RunN(context.Background(), StoreTarget(s), wl, Config{Prefix: DefaultPrefix, Keys: 100, Workers: 4}, 1000)
+	require.ErrorContains(t, res.Err, "not found")
+	require.Zero(t, res.Ops)
+	require.Less(t, res.Errors, int64(1000))
+}
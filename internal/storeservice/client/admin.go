@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+)
+
+const restoreChunkSize = 256 << 10
+
+// Backup writes a backup of the store to w and returns the version to
+// pass as since for the next incremental backup.
+func (c *Client) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	ac := storepb.NewAdminClient(c.conn.ClientConn)
+	stream, err := ac.Backup(ctx, &storepb.BackupRequest{Since: since})
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+
+		if chunk.Error != nil {
+			return 0, errorFromProto(chunk.Error)
+		}
+		if len(chunk.Data) == 0 {
+			// The last chunk carries only the version.
+			return chunk.Version, nil
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Restore loads the backup read from r into the store.
+func (c *Client) Restore(ctx context.Context, r io.Reader) error {
+	// Cancelling aborts the restore when r fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ac := storepb.NewAdminClient(c.conn.ClientConn)
+	stream, err := ac.Restore(ctx)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, restoreChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&storepb.RestoreChunk{Data: buf[:n]}); err != nil {
+				// The server stopped reading, its response has the reason.
+				break
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != nil {
+		return errorFromProto(resp.Error)
+	}
+
+	return nil
+}
+
+// Count returns the number of keys with the prefix.
+func (c *Client) Count(ctx context.Context, prefix string) (int64, error) {
+	ac := storepb.NewAdminClient(c.conn.ClientConn)
+	resp, err := ac.Count(ctx, &storepb.CountRequest{Prefix: prefix})
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.Error != nil {
+		return 0, errorFromProto(resp.Error)
+	}
+
+	return resp.Count, nil
+}
+
+func (c *Client) Stats(ctx context.Context) (manager.Stats, error) {
+	ac := storepb.NewAdminClient(c.conn.ClientConn)
+	resp, err := ac.Stats(ctx, &storepb.StatsRequest{})
+	if err != nil {
+		return manager.Stats{}, err
+	}
+
+	if resp.Error != nil {
+		return manager.Stats{}, errorFromProto(resp.Error)
+	}
+
+	return manager.Stats{
+		Keys:         resp.Keys,
+		LogicalBytes: resp.LogicalBytes,
+		DiskBytes:    resp.DiskBytes,
+		LSMBytes:     resp.LsmBytes,
+		VlogBytes:    resp.VlogBytes,
+	}, nil
+}
+
+// Compact reclaims the disk space of overwritten and deleted keys and
+// returns the number of bytes freed.
+func (c *Client) Compact(ctx context.Context) (int64, error) {
+	ac := storepb.NewAdminClient(c.conn.ClientConn)
+	resp, err := ac.Compact(ctx, &storepb.CompactRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.Error != nil {
+		return 0, errorFromProto(resp.Error)
+	}
+
+	return resp.ReclaimedBytes, nil
+}
+
+// RotateKeys creates new data keys for the encrypted namespaces and
+// returns their number. With reencrypt the store also rewrites the older
+// values in the background.
+func (c *Client) RotateKeys(ctx context.Context, reencrypt bool) (int64, error) {
+	ac := storepb.NewAdminClient(c.conn.ClientConn)
+	resp, err := ac.RotateKeys(ctx, &storepb.RotateKeysRequest{
+		Reencrypt: reencrypt,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.Error != nil {
+		return 0, errorFromProto(resp.Error)
+	}
+
+	return resp.Namespaces, nil
+}
@@ -2,9 +2,10 @@ package client
 
 import (
 	"context"
-	"errors"
 	"kvstore/internal/common/grpcclient"
 	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+	"time"
 )
 
 type Client struct {
@@ -17,29 +18,187 @@ func New(cl *grpcclient.GRPCClient) *Client {
 	}
 }
 
-func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
+func (c *Client) Get(ctx context.Context, key string) (manager.GetResult, error) {
 	sc := storepb.NewStoreClient(c.conn.ClientConn)
 	resp, err := sc.Get(ctx, &storepb.GetRequest{Key: key})
 	if err != nil {
-		return nil, err
+		return manager.GetResult{}, err
 	}
 
 	if resp.Error != nil {
-		return nil, errors.New(resp.Error.Message)
+		return manager.GetResult{}, errorFromProto(resp.Error)
 	}
 
-	return resp.Value, nil
+	return manager.GetResult{
+		KeyValuePair: manager.KeyValuePair{
+			Key:   key,
+			Value: string(resp.Value),
+		},
+		Version: resp.Version,
+	}, nil
 }
 
-func (c *Client) Put(ctx context.Context, key string, value []byte) error {
+func (c *Client) Put(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.put(ctx, &storepb.PutRequest{
+		Key:   key,
+		Value: value,
+		TtlMs: ttl.Milliseconds(),
+	})
+}
+
+func (c *Client) PutIfVersion(ctx context.Context, key string, value []byte, version uint64) error {
+	return c.put(ctx, &storepb.PutRequest{
+		Key:       key,
+		Value:     value,
+		IfVersion: version,
+	})
+}
+
+func (c *Client) PutIfAbsent(ctx context.Context, key string, value []byte) error {
+	return c.put(ctx, &storepb.PutRequest{
+		Key:      key,
+		Value:    value,
+		IfAbsent: true,
+	})
+}
+
+func (c *Client) put(ctx context.Context, req *storepb.PutRequest) error {
+	sc := storepb.NewStoreClient(c.conn.ClientConn)
+	resp, err := sc.Put(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != nil {
+		return errorFromProto(resp.Error)
+	}
+
+	return nil
+}
+
+func (c *Client) Delete(ctx context.Context, key string) error {
+	return c.DeleteIfVersion(ctx, key, 0)
+}
+
+// DeleteIfVersion removes the key only if it has the given version, zero
+// version makes the delete unconditional.
+func (c *Client) DeleteIfVersion(ctx context.Context, key string, version uint64) error {
+	sc := storepb.NewStoreClient(c.conn.ClientConn)
+	resp, err := sc.Delete(ctx, &storepb.DeleteRequest{
+		Key:       key,
+		IfVersion: version,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != nil {
+		return errorFromProto(resp.Error)
+	}
+
+	return nil
+}
+
+// DeletePrefix deletes the keys with the prefix and returns their number.
+func (c *Client) DeletePrefix(ctx context.Context, prefix string, opts manager.DeleteOptions) (int64, error) {
+	sc := storepb.NewStoreClient(c.conn.ClientConn)
+	resp, err := sc.DeletePrefix(ctx, &storepb.DeletePrefixRequest{
+		Prefix: prefix,
+		DryRun: opts.DryRun,
+		All:    opts.All,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.Error != nil {
+		return 0, errorFromProto(resp.Error)
+	}
+
+	return resp.Count, nil
+}
+
+// DeleteRange deletes the keys in [start, end) and returns their number.
+func (c *Client) DeleteRange(ctx context.Context, start, end string, opts manager.DeleteOptions) (int64, error) {
+	sc := storepb.NewStoreClient(c.conn.ClientConn)
+	resp, err := sc.DeleteRange(ctx, &storepb.DeleteRangeRequest{
+		Start:  start,
+		End:    end,
+		DryRun: opts.DryRun,
+		All:    opts.All,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.Error != nil {
+		return 0, errorFromProto(resp.Error)
+	}
+
+	return resp.Count, nil
+}
+
+func (c *Client) Scan(ctx context.Context, opts manager.ScanOptions) (manager.ScanResult, error) {
+	sc := storepb.NewStoreClient(c.conn.ClientConn)
+	resp, err := sc.Scan(ctx, &storepb.ScanRequest{
+		Prefix:  opts.Prefix,
+		Limit:   int32(opts.Limit),
+		Start:   opts.Start,
+		End:     opts.End,
+		Reverse: opts.Reverse,
+		Cursor:  opts.Cursor,
+	})
+	if err != nil {
+		return manager.ScanResult{}, err
+	}
+
+	if resp.Error != nil {
+		return manager.ScanResult{}, errorFromProto(resp.Error)
+	}
+
+	list := make([]manager.KeyValuePair, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		list = append(list, manager.KeyValuePair{
+			Key:   item.Key,
+			Value: string(item.Value),
+		})
+	}
+
+	return manager.ScanResult{
+		List:       list,
+		NextCursor: resp.NextCursor,
+	}, nil
+}
+
+func (c *Client) Batch(ctx context.Context, ops []manager.Op) error {
+	req := &storepb.BatchRequest{
+		Ops: make([]*storepb.Op, 0, len(ops)),
+	}
+	for _, op := range ops {
+		o := &storepb.Op{
+			Key:   string(op.Key),
+			Value: op.Value,
+			TtlMs: op.TTL.Milliseconds(),
+		}
+		switch op.Type {
+		case manager.OpSet:
+			o.Type = storepb.OP_SET
+		case manager.OpDelete:
+			o.Type = storepb.OP_DELETE
+		default:
+			return manager.ErrUnknownOp
+		}
+		req.Ops = append(req.Ops, o)
+	}
+
 	sc := storepb.NewStoreClient(c.conn.ClientConn)
-	resp, err := sc.Put(ctx, &storepb.PutRequest{Key: key, Value: value})
+	resp, err := sc.Batch(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	if resp.Error != nil {
-		return errors.New(resp.Error.Message)
+		return errorFromProto(resp.Error)
 	}
 
 	return nil
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
+func errorFromProto(e *storepb.Error) error {
+	switch e.Code {
+	case storepb.ERROR_NOT_FOUND:
+		return manager.ErrNotFound
+	case storepb.ERROR_CONFLICT:
+		return manager.ErrConflict
+	case storepb.ERROR_WATCH_LAGGED:
+		return manager.ErrWatchLagged
+	case storepb.ERROR_INVALID_ARGUMENT:
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, e.Message)
+	}
+
+	return errors.New(e.Message)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+)
+
+// Watch calls f with the changes of the keys with the prefix until ctx
+// is done, f fails or the store ends the watch. A non-zero fromVersion
+// first replays the current values of the keys written after it.
+func (c *Client) Watch(ctx context.Context, prefix string, fromVersion uint64, f func(manager.Event) error) error {
+	// Cancelling ends the stream when f fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sc := storepb.NewStoreClient(c.conn.ClientConn)
+	stream, err := sc.Watch(ctx, &storepb.WatchRequest{
+		Prefix:      prefix,
+		FromVersion: fromVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+
+		if event.Error != nil {
+			return errorFromProto(event.Error)
+		}
+
+		e := manager.Event{
+			Type:    manager.EventPut,
+			Key:     event.Key,
+			Value:   string(event.Value),
+			Version: event.Version,
+		}
+		if event.Type == storepb.EVENT_DELETE {
+			e.Type = manager.EventDelete
+		}
+		if err := f(e); err != nil {
+			return err
+		}
+	}
+}
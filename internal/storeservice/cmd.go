@@ -1,10 +1,22 @@
 package storeservice
 
 import (
+	"errors"
+	"fmt"
 	"kvstore/internal/common"
+	"kvstore/internal/common/grpcclient"
 	"kvstore/internal/common/grpcserver"
+	"kvstore/internal/common/metricsserver"
+	"kvstore/internal/storeservice/bench"
+	"kvstore/internal/storeservice/client"
 	"kvstore/internal/storeservice/manager"
-	"kvstore/internal/storeservice/store/badgerkv"
+	"kvstore/internal/storeservice/server"
+	"kvstore/internal/storeservice/store"
+	"kvstore/internal/storeservice/store/cachekv"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 )
@@ -15,29 +27,205 @@ var CLICommand = &cli.Command{
 	Subcommands: []*cli.Command{
 		{
 			Name: "run",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:  "address",
+					Value: "localhost:20001",
+				},
+				&cli.StringFlag{
+					Name:  "metrics-address",
+					Usage: "serve prometheus metrics on /metrics over HTTP, empty disables it",
+					Value: "localhost:20002",
+				},
+				&cli.DurationFlag{
+					Name:  "txn-timeout",
+					Usage: "rollback transactions idle for longer than this",
+					Value: 30 * time.Second,
+				},
+				&cli.DurationFlag{
+					Name:  "snapshot-timeout",
+					Usage: "release snapshots idle for longer than this",
+					Value: time.Minute,
+				},
+				&cli.StringFlag{
+					Name:  "backend",
+					Usage: "storage backend: " + backendNames(),
+					Value: "badger",
+				},
+				&cli.BoolFlag{
+					Name:  "compression",
+					Usage: "compress values with snappy",
+				},
+				&cli.StringFlag{
+					Name:  "encryption-key-file",
+					Usage: "file with the master keys encrypting values, one \"<id> <hex key>\" per line, the last is current",
+				},
+				&cli.StringFlag{
+					Name:  "namespace-separator",
+					Usage: "ends the namespace part of keys, each namespace has its own data keys",
+					Value: "/",
+				},
+				&cli.BoolFlag{
+					Name:  "reencrypt-on-read",
+					Usage: "rewrite values read with an old data key",
+				},
+				&cli.Int64Flag{
+					Name:     "cache-size",
+					Usage:    "bytes of values cached in memory in front of the backend, 0 disables the cache",
+					Category: "cache",
+				},
+				&cli.DurationFlag{
+					Name:     "cache-ttl",
+					Usage:    "how long a value is served from the cache, bounds how long a write bypassing the cache goes unseen",
+					Value:    time.Minute,
+					Category: "cache",
+				},
+				&cli.DurationFlag{
+					Name:     "cache-negative-ttl",
+					Usage:    "how long a missing key is cached, 0 does not cache missing keys",
+					Category: "cache",
+				},
+			}, backendFlags()...),
+			Action: runStore,
+		},
+		{
+			Name:  "backup",
+			Usage: "Write a backup of a running store to a file",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:  "address",
 					Value: "localhost:20001",
 				},
+				&cli.StringFlag{
+					Name:     "output",
+					Required: true,
+				},
+				&cli.Uint64Flag{
+					Name:  "since",
+					Usage: "version printed by a previous backup, takes an incremental backup",
+				},
 			},
-			Action: runStore,
+			Action: runBackup,
+		},
+		{
+			Name:  "restore",
+			Usage: "Load a backup file into a running store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "address",
+					Value: "localhost:20001",
+				},
+				&cli.StringFlag{
+					Name:     "input",
+					Required: true,
+				},
+			},
+			Action: runRestore,
+		},
+		{
+			Name:  "compact",
+			Usage: "Reclaim the disk space of overwritten and deleted keys of a running store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "address",
+					Value: "localhost:20001",
+				},
+			},
+			Action: runCompact,
+		},
+		{
+			Name:  "bench",
+			Usage: "Run the standard benchmark workloads against a running store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "address",
+					Value: "localhost:20001",
+				},
+				&cli.StringSliceFlag{
+					Name:  "workload",
+					Usage: "workloads to run: " + workloadNames() + ", all by default",
+				},
+				&cli.IntSliceFlag{
+					Name:  "value-size",
+					Usage: "sizes of the values written in bytes",
+					Value: cli.NewIntSlice(bench.ValueSizes...),
+				},
+				&cli.IntSliceFlag{
+					Name:  "workers",
+					Usage: "numbers of concurrent clients",
+					Value: cli.NewIntSlice(bench.Parallelism...),
+				},
+				&cli.DurationFlag{
+					Name:  "duration",
+					Usage: "how long every workload runs with each value size and number of workers",
+					Value: 5 * time.Second,
+				},
+				&cli.IntFlag{
+					Name:  "keys",
+					Usage: "number of keys preloaded for the workloads which read",
+					Value: bench.DefaultKeys,
+				},
+				&cli.StringFlag{
+					Name:  "prefix",
+					Usage: "prefix of the keys written, they are deleted when the benchmark ends",
+					Value: bench.DefaultPrefix,
+				},
+			},
+			Action: runBench,
+		},
+		{
+			Name:  "rotate-keys",
+			Usage: "Create new data keys for the encrypted values of a running store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "address",
+					Value: "localhost:20001",
+				},
+				&cli.BoolFlag{
+					Name:  "reencrypt",
+					Usage: "rewrite the values encrypted with older keys in the background",
+				},
+			},
+			Action: runRotateKeys,
 		},
 	},
 }
 
 func runStore(ctx *cli.Context) error {
+	var masterKeys *manager.MasterKeys
+	if path := ctx.String("encryption-key-file"); path != "" {
+		var err error
+		if masterKeys, err = manager.LoadKeyFile(path); err != nil {
+			return err
+		}
+	}
+
 	store := New(
 		Config{
 			Server: grpcserver.Config{
 				Address: ctx.String("address"),
 			},
+			Metrics: metricsserver.Config{
+				Address: ctx.String("metrics-address"),
+			},
+			API: server.Config{
+				TxnTimeout:      ctx.Duration("txn-timeout"),
+				SnapshotTimeout: ctx.Duration("snapshot-timeout"),
+			},
 			Manager: manager.Config{
-				UseCompression: false,
+				UseCompression:     ctx.Bool("compression"),
+				MasterKeys:         masterKeys,
+				NamespaceSeparator: ctx.String("namespace-separator"),
+				ReencryptOnRead:    ctx.Bool("reencrypt-on-read"),
+			},
+			Store: store.Config{
+				Backend: ctx.String("backend"),
+				Options: backendOptions(ctx, ctx.String("backend")),
 			},
-			Store: badgerkv.Config{
-				InMem: true,
-				Root:  "/tmp/store-temp",
+			Cache: cachekv.Config{
+				MaxBytes:    ctx.Int64("cache-size"),
+				TTL:         ctx.Duration("cache-ttl"),
+				NegativeTTL: ctx.Duration("cache-negative-ttl"),
 			},
 		},
 		Dependencies{
@@ -48,3 +236,178 @@ func runStore(ctx *cli.Context) error {
 
 	return store.Run(ctx.Context)
 }
+
+func dialStore(ctx *cli.Context) (*client.Client, error) {
+	conn := grpcclient.New(grpcclient.Config{
+		Address: ctx.String("address"),
+	}, grpcclient.Dependencies{})
+	if err := conn.Run(ctx.Context); err != nil {
+		return nil, err
+	}
+
+	return client.New(conn), nil
+}
+
+func runBackup(ctx *cli.Context) error {
+	cl, err := dialStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	output := ctx.String("output")
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+
+	version, err := cl.Backup(ctx.Context, f, ctx.Uint64("since"))
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(output)
+		return fmt.Errorf("backup: %w", err)
+	}
+
+	fmt.Println(version)
+	return nil
+}
+
+func runRestore(ctx *cli.Context) error {
+	cl, err := dialStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(ctx.String("input"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := cl.Restore(ctx.Context, f); err != nil {
+		return fmt.Errorf("restore: %w", err)
+	}
+	return nil
+}
+
+func runCompact(ctx *cli.Context) error {
+	cl, err := dialStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	reclaimed, err := cl.Compact(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("compact: %w", err)
+	}
+
+	fmt.Println(reclaimed)
+	return nil
+}
+
+func runRotateKeys(ctx *cli.Context) error {
+	cl, err := dialStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := cl.RotateKeys(ctx.Context, ctx.Bool("reencrypt"))
+	if err != nil {
+		return fmt.Errorf("rotate keys: %w", err)
+	}
+
+	fmt.Println(n)
+	return nil
+}
+
+func runBench(ctx *cli.Context) error {
+	workloads := bench.Workloads
+	if names := ctx.StringSlice("workload"); len(names) > 0 {
+		workloads = nil
+		for _, name := range names {
+			wl, err := bench.Find(name)
+			if err != nil {
+				return err
+			}
+			workloads = append(workloads, wl)
+		}
+	}
+
+	cfg := bench.Config{
+		Prefix: ctx.String("prefix"),
+		Keys:   ctx.Int("keys"),
+	}
+	if cfg.Prefix == "" {
+		return errors.New("bench: the keys need a prefix to be deleted afterwards")
+	}
+	if cfg.Keys < 100 {
+		return errors.New("bench: at least 100 keys are needed")
+	}
+	sizes, workers := ctx.IntSlice("value-size"), ctx.IntSlice("workers")
+	if len(sizes) == 0 || slices.Min(sizes) < 0 {
+		return errors.New("bench: value sizes can not be negative")
+	}
+	if len(workers) == 0 || slices.Min(workers) < 1 {
+		return errors.New("bench: at least one worker is needed")
+	}
+
+	cl, err := dialStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	return runWorkloads(ctx.Context, cl, workloads, cfg, sizes, workers, ctx.Duration("duration"))
+}
+
+func workloadNames() string {
+	var names []string
+	for _, wl := range bench.Workloads {
+		names = append(names, wl.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// backendFlags exposes the options of every backend as --<backend>-<option>.
+func backendFlags() []cli.Flag {
+	var flags []cli.Flag
+	for _, b := range store.Backends() {
+		for _, o := range b.Options {
+			flags = append(flags, &cli.StringFlag{
+				Name:     b.Name + "-" + o.Name,
+				Usage:    o.Usage,
+				Value:    o.Default,
+				Category: b.Name + " backend",
+			})
+		}
+	}
+	return flags
+}
+
+// backendOptions collects the options of the backend set on the command
+// line, unset options take their defaults.
+func backendOptions(ctx *cli.Context, backend string) store.Options {
+	opts := store.Options{}
+	for _, b := range store.Backends() {
+		if b.Name != backend {
+			continue
+		}
+		for _, o := range b.Options {
+			if name := b.Name + "-" + o.Name; ctx.IsSet(name) {
+				opts[o.Name] = ctx.String(name)
+			}
+		}
+	}
+	return opts
+}
+
+func backendNames() string {
+	var names []string
+	for _, b := range store.Backends() {
+		names = append(names, b.Name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,21 @@
+package manager
+
+import (
+	"context"
+	"io"
+)
+
+// Backup dumps the raw entries of the store, values stay encoded so a
+// backup is restored as is.
+func (m *manager) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	return m.deps.Store.Backup(ctx, w, since)
+}
+
+// Restore also restores the data keys of encrypted values, the cached
+// ones are read again.
+func (m *manager) Restore(ctx context.Context, r io.Reader) error {
+	if m.keys != nil {
+		defer m.keys.reset()
+	}
+	return m.deps.Store.Restore(ctx, r)
+}
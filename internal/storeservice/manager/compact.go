@@ -0,0 +1,32 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+)
+
+// ErrCompactUnsupported is returned by Compact for backends which do not
+// compact on demand.
+var ErrCompactUnsupported = kv.ErrCompactUnsupported
+
+// Compact reclaims the disk space of overwritten and deleted keys and
+// returns the number of bytes freed.
+func (m *manager) Compact(ctx context.Context) (int64, error) {
+	c, ok := m.deps.Store.(kv.Compactor)
+	if !ok {
+		return 0, ErrCompactUnsupported
+	}
+
+	reclaimed, err := c.Compact(ctx)
+	if errors.Is(err, ErrCompactUnsupported) {
+		// A wrapping store found out that the backend cannot compact.
+		return 0, err
+	}
+	if err != nil {
+		m.log.WithError(err).Error("compaction failed")
+		return 0, err
+	}
+	m.log.WithField("reclaimed", reclaimed).Info("compaction finished")
+	return reclaimed, nil
+}
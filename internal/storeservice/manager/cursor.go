@@ -0,0 +1,31 @@
+package manager
+
+import (
+	"encoding/base64"
+	"errors"
+)
+
+// ErrInvalidCursor is returned by Scan for a cursor it did not issue.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// cursorVersion leads every cursor, so that its encoding can change
+// without misreading the cursors handed out before.
+const cursorVersion byte = 1
+
+// encodeCursor returns an opaque cursor resuming a scan after the key.
+func encodeCursor(key []byte) string {
+	buf := make([]byte, 0, len(key)+1)
+	buf = append(buf, cursorVersion)
+	buf = append(buf, key...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// decodeCursor returns the last key of the page the cursor was issued
+// for.
+func decodeCursor(cursor string) ([]byte, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(buf) < 2 || buf[0] != cursorVersion {
+		return nil, ErrInvalidCursor
+	}
+	return buf[1:], nil
+}
@@ -0,0 +1,55 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+)
+
+// ErrEmptyPrefix is returned by bulk deletes which would delete every
+// key without DeleteOptions.All.
+var ErrEmptyPrefix = errors.New("empty prefix or range deletes every key")
+
+type DeleteOptions struct {
+	// DryRun counts the keys which would be deleted without deleting
+	// them.
+	DryRun bool
+	// All allows an empty prefix or a range without bounds, which
+	// deletes every key.
+	All bool
+}
+
+// DeletePrefix deletes the keys with the prefix and returns their number.
+func (m *manager) DeletePrefix(ctx context.Context, prefix string, opts DeleteOptions) (int64, error) {
+	if prefix == "" && !opts.All {
+		return 0, ErrEmptyPrefix
+	}
+
+	wrapped := wrapDataKey([]byte(prefix))
+	if opts.DryRun {
+		return m.deps.Store.Count(ctx, wrapped)
+	}
+	return m.deps.Store.DeletePrefix(ctx, wrapped)
+}
+
+// DeleteRange deletes the keys in [start, end) and returns their number.
+// Empty start or end leaves the range unbounded on that side.
+func (m *manager) DeleteRange(ctx context.Context, start, end string, opts DeleteOptions) (int64, error) {
+	if start == "" && end == "" && !opts.All {
+		return 0, ErrEmptyPrefix
+	}
+
+	scanOpts := kv.ScanOptions{Prefix: wrapDataKey(nil)}
+	if start != "" {
+		scanOpts.Start = wrapDataKey([]byte(start))
+	}
+	if end != "" {
+		scanOpts.End = wrapDataKey([]byte(end))
+	}
+
+	lower, upper := scanOpts.Bounds()
+	if opts.DryRun {
+		return m.deps.Store.CountRange(ctx, lower, upper)
+	}
+	return m.deps.Store.DeleteRange(ctx, lower, upper)
+}
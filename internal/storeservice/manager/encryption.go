@@ -0,0 +1,593 @@
+package manager
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrEncryptionDisabled is returned by the key rotation and for
+// encrypted values when the manager has no master keys.
+var ErrEncryptionDisabled = errors.New("encryption is not configured")
+
+var (
+	errUnknownKey   = errors.New("unknown encryption key")
+	errCorruptedKey = errors.New("corrupted data key")
+)
+
+const (
+	// dataKeySize makes the data keys AES-256 keys.
+	dataKeySize = 32
+	// defaultNamespaceSeparator ends the namespace of a key.
+	defaultNamespaceSeparator = "/"
+	// reencryptBatchSize is the number of keys Reencrypt reads at once.
+	reencryptBatchSize = 1000
+)
+
+// keyringPrefix holds the wrapped data keys, next to the data rather
+// than in it so they are neither scanned nor watched but are backed up.
+var keyringPrefix = []byte("keys/")
+
+type MasterKey struct {
+	// ID names the master key in the data keys it wraps and must never
+	// be reused for another key.
+	ID uint32
+	// Key is an AES key of 16, 24 or 32 bytes.
+	Key []byte
+}
+
+// MasterKeys wrap the data keys, the last one wraps new data keys and
+// the others unwrap the data keys created before a rotation.
+type MasterKeys struct {
+	aeads   map[uint32]cipher.AEAD
+	current uint32
+}
+
+func NewMasterKeys(keys []MasterKey) (*MasterKeys, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no master keys")
+	}
+
+	mk := &MasterKeys{aeads: make(map[uint32]cipher.AEAD, len(keys))}
+	for _, k := range keys {
+		if _, ok := mk.aeads[k.ID]; ok {
+			return nil, fmt.Errorf("master key %d: duplicate id", k.ID)
+		}
+		aead, err := newAEAD(k.Key)
+		if err != nil {
+			return nil, fmt.Errorf("master key %d: %w", k.ID, err)
+		}
+		mk.aeads[k.ID] = aead
+		mk.current = k.ID
+	}
+	return mk, nil
+}
+
+// LoadKeyFile reads master keys from a file with one "<id> <hex key>"
+// pair per line, empty lines and lines starting with # are skipped. A
+// new master key is appended to the file, the previous one stays until
+// RotateKeys and Reencrypt replaced the data keys it wrapped.
+func LoadKeyFile(path string) (*MasterKeys, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+	defer f.Close()
+
+	var keys []MasterKey
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		idText, keyText, ok := strings.Cut(text, " ")
+		if !ok {
+			return nil, fmt.Errorf("key file %s:%d: want <id> <hex key>", path, line)
+		}
+		id, err := strconv.ParseUint(idText, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("key file %s:%d: %w", path, line, err)
+		}
+		key, err := hex.DecodeString(strings.TrimSpace(keyText))
+		if err != nil {
+			return nil, fmt.Errorf("key file %s:%d: %w", path, line, err)
+		}
+		keys = append(keys, MasterKey{ID: uint32(id), Key: key})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+
+	mk, err := NewMasterKeys(keys)
+	if err != nil {
+		return nil, fmt.Errorf("key file %s: %w", path, err)
+	}
+	return mk, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal appends a random nonce and the sealed plaintext to dst. The
+// additional data binds the ciphertext to its key in the store.
+func seal(dst []byte, aead cipher.AEAD, plaintext, ad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	dst = append(dst, nonce...)
+	return aead.Seal(dst, nonce, plaintext, ad), nil
+}
+
+func unseal(aead cipher.AEAD, data, ad []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errUnknownEncoding
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, ad)
+}
+
+// keyring keeps the data keys of the namespaces. They are stored under
+// keyringPrefix wrapped by a master key, with random ids so backups of
+// other stores restore without clobbering them, and cached once read.
+type keyring struct {
+	masters   *MasterKeys
+	separator []byte
+	store     kv.Store
+
+	mu         sync.Mutex
+	namespaces map[string]*namespaceKeys
+}
+
+type namespaceKeys struct {
+	// current encrypts new values, it is the key of the highest
+	// generation which could be unwrapped.
+	current    uint32
+	generation uint32
+	keys       map[uint32]cipher.AEAD
+}
+
+func newKeyring(masters *MasterKeys, separator string, store kv.Store) *keyring {
+	if separator == "" {
+		separator = defaultNamespaceSeparator
+	}
+	return &keyring{
+		masters:    masters,
+		separator:  []byte(separator),
+		store:      store,
+		namespaces: make(map[string]*namespaceKeys),
+	}
+}
+
+// namespace returns the part of a stored data key before the separator,
+// keys without the separator share the empty namespace.
+func (r *keyring) namespace(key kv.Key) string {
+	key = key[len(keyPrefix):]
+	ns, _, ok := bytes.Cut(key, r.separator)
+	if !ok {
+		return ""
+	}
+	return string(ns)
+}
+
+// reset drops the cached data keys, a restore may have replaced them.
+func (r *keyring) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.namespaces = make(map[string]*namespaceKeys)
+}
+
+// currentKey returns the data key encrypting new values of the
+// namespace and creates it on the first write.
+func (r *keyring) currentKey(ctx context.Context, ns string) (uint32, cipher.AEAD, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys, err := r.cached(ctx, ns)
+	if err != nil {
+		return 0, nil, err
+	}
+	if keys.current == 0 {
+		if err := r.create(ctx, ns, keys); err != nil {
+			return 0, nil, err
+		}
+	}
+	return keys.current, keys.keys[keys.current], nil
+}
+
+// key returns a data key of the namespace, a key missing from the cache
+// is looked up in the store again.
+func (r *keyring) key(ctx context.Context, ns string, id uint32) (cipher.AEAD, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys, err := r.cached(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	if aead, ok := keys.keys[id]; ok {
+		return aead, nil
+	}
+
+	if keys, err = r.load(ctx, ns); err != nil {
+		return nil, err
+	}
+	r.namespaces[ns] = keys
+	if aead, ok := keys.keys[id]; ok {
+		return aead, nil
+	}
+	return nil, fmt.Errorf("%w: data key %d of namespace %q", errUnknownKey, id, ns)
+}
+
+// rotate creates a new data key for every namespace and returns their
+// number. Values keep their data key until they are written again.
+func (r *keyring) rotate(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	namespaces := make(map[string]struct{})
+	err := r.store.Scan(ctx, kv.ScanOptions{Prefix: keyringPrefix}, func(k kv.Key, _ kv.Value) error {
+		ns, _, ok := parseKeyName(k)
+		if !ok {
+			return errCorruptedKey
+		}
+		namespaces[ns] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for ns := range namespaces {
+		keys, err := r.load(ctx, ns)
+		if err != nil {
+			return 0, err
+		}
+		r.namespaces[ns] = keys
+		if err := r.create(ctx, ns, keys); err != nil {
+			return 0, err
+		}
+	}
+	return len(namespaces), nil
+}
+
+// cached must be called with r.mu held.
+func (r *keyring) cached(ctx context.Context, ns string) (*namespaceKeys, error) {
+	if keys, ok := r.namespaces[ns]; ok {
+		return keys, nil
+	}
+
+	keys, err := r.load(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	r.namespaces[ns] = keys
+	return keys, nil
+}
+
+// load reads the data keys of the namespace. Keys wrapped by a master
+// key which is not loaded are skipped, the values they encrypt fail to
+// decrypt.
+func (r *keyring) load(ctx context.Context, ns string) (*namespaceKeys, error) {
+	keys := &namespaceKeys{keys: make(map[uint32]cipher.AEAD)}
+	var currentGeneration uint32
+
+	err := r.store.Scan(ctx, kv.ScanOptions{Prefix: keyNamePrefix(ns)}, func(k kv.Key, v kv.Value) error {
+		name, id, ok := parseKeyName(k)
+		if !ok {
+			return errCorruptedKey
+		}
+		if name != ns {
+			// A longer namespace sharing the prefix.
+			return nil
+		}
+		if len(v) < 8 {
+			return errCorruptedKey
+		}
+
+		masterID, generation := binary.BigEndian.Uint32(v), binary.BigEndian.Uint32(v[4:])
+		keys.generation = max(keys.generation, generation)
+		master, ok := r.masters.aeads[masterID]
+		if !ok {
+			return nil
+		}
+		dataKey, err := unseal(master, v[8:], k)
+		if err != nil {
+			return fmt.Errorf("unwrap data key %d of namespace %q: %w", id, ns, err)
+		}
+		aead, err := newAEAD(dataKey)
+		if err != nil {
+			return err
+		}
+
+		keys.keys[id] = aead
+		if keys.current == 0 || generation > currentGeneration || generation == currentGeneration && id > keys.current {
+			keys.current, currentGeneration = id, generation
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// create stores a new data key of the namespace wrapped by the current
+// master key and makes it current. It must be called with r.mu held.
+func (r *keyring) create(ctx context.Context, ns string, keys *namespaceKeys) error {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return err
+	}
+	generation := keys.generation + 1
+
+	for {
+		var idBuf [4]byte
+		if _, err := rand.Read(idBuf[:]); err != nil {
+			return err
+		}
+		id := binary.BigEndian.Uint32(idBuf[:])
+		if id == 0 {
+			continue
+		}
+
+		name := keyName(ns, id)
+		record := binary.BigEndian.AppendUint32(nil, r.masters.current)
+		record = binary.BigEndian.AppendUint32(record, generation)
+		record, err := seal(record, r.masters.aeads[r.masters.current], dataKey, name)
+		if err != nil {
+			return err
+		}
+
+		err = r.store.SetIfAbsent(ctx, name, record)
+		if errors.Is(err, kv.ErrConflict) {
+			// The random id is taken.
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		keys.keys[id] = aead
+		keys.current, keys.generation = id, generation
+		return nil
+	}
+}
+
+// keyName is the stored name of a data key: the namespace terminated by
+// a zero byte and the id.
+func keyName(ns string, id uint32) kv.Key {
+	return binary.BigEndian.AppendUint32(keyNamePrefix(ns), id)
+}
+
+func keyNamePrefix(ns string) kv.Key {
+	name := make(kv.Key, 0, len(keyringPrefix)+len(ns)+5)
+	name = append(name, keyringPrefix...)
+	name = append(name, ns...)
+	return append(name, 0)
+}
+
+func parseKeyName(name kv.Key) (string, uint32, bool) {
+	if len(name) < len(keyringPrefix)+5 || name[len(name)-5] != 0 {
+		return "", 0, false
+	}
+	ns := name[len(keyringPrefix) : len(name)-5]
+	return string(ns), binary.BigEndian.Uint32(name[len(name)-4:]), true
+}
+
+// RotateKeys creates a new data key for every namespace, which encrypts
+// the values written from now on. Older values are re-encrypted when
+// read with Config.ReencryptOnRead, or by Reencrypt.
+func (m *manager) RotateKeys(ctx context.Context) (int, error) {
+	if m.keys == nil {
+		return 0, ErrEncryptionDisabled
+	}
+
+	n, err := m.keys.rotate(ctx)
+	if err != nil {
+		m.log.WithError(err).Error("key rotation failed")
+		return 0, err
+	}
+	m.log.WithField("namespaces", n).Info("data keys rotated")
+	return n, nil
+}
+
+// Reencrypt rewrites the values which are not encrypted with the current
+// data key of their namespace, including values written before the
+// encryption was enabled, and returns their number. Values written
+// meanwhile are left alone. Rewritten values keep their expiry, the
+// store must be a kv.Expirer.
+func (m *manager) Reencrypt(ctx context.Context) (int64, error) {
+	if m.keys == nil {
+		return 0, ErrEncryptionDisabled
+	}
+
+	var (
+		count int64
+		after kv.Key
+	)
+	for {
+		// The batch is read first, the keyring must not be used from a
+		// scan callback as it reads and writes the store itself.
+		batch, err := m.scanRaw(ctx, m.deps.Store.Scan, kv.ScanOptions{
+			Prefix: wrapDataKey(nil),
+			After:  after,
+			Limit:  reencryptBatchSize,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, e := range batch {
+			stale, err := m.isStale(ctx, e.key, e.value)
+			if err != nil {
+				return 0, err
+			}
+			if !stale {
+				continue
+			}
+
+			rewritten, err := m.reencrypt(ctx, e.key)
+			if err != nil {
+				return 0, err
+			}
+			if rewritten {
+				count++
+			}
+		}
+
+		if len(batch) < reencryptBatchSize {
+			return count, nil
+		}
+		after = batch[len(batch)-1].key
+	}
+}
+
+// reencrypt rewrites the value of the stored key if it is still stale.
+func (m *manager) reencrypt(ctx context.Context, key kv.Key) (bool, error) {
+	value, version, err := m.deps.Store.GetWithVersion(ctx, key)
+	if errors.Is(err, kv.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return m.reencryptValue(ctx, key, value, version)
+}
+
+func (m *manager) reencryptValue(ctx context.Context, key kv.Key, value kv.Value, version uint64) (bool, error) {
+	if ok, err := m.isStale(ctx, key, value); !ok || err != nil {
+		return false, err
+	}
+
+	data, err := m.decodeValue(ctx, key, value)
+	if err != nil {
+		return false, err
+	}
+	encoded, err := m.encodeValue(ctx, key, data)
+	if err != nil {
+		return false, err
+	}
+
+	err = m.rewrite(ctx, key, encoded, version)
+	if errors.Is(err, kv.ErrConflict) {
+		// Written, deleted or expired meanwhile.
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// rewrite replaces the value of the key if it still has the version and
+// keeps its expiry. A version check can not set a TTL, so the value is
+// written by a transaction which has read the key.
+func (m *manager) rewrite(ctx context.Context, key kv.Key, value kv.Value, version uint64) error {
+	e, ok := m.deps.Store.(kv.Expirer)
+	if !ok {
+		return kv.ErrExpiryUnsupported
+	}
+
+	txn, err := m.deps.Store.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	// The expiry is read after the transaction began, so the version
+	// check covers the writes before it and the commit those after it.
+	_, current, expiresAt, err := e.GetWithExpiry(ctx, key)
+	if errors.Is(err, kv.ErrNotFound) {
+		return kv.ErrConflict
+	} else if err != nil {
+		return err
+	}
+	if current != version {
+		return kv.ErrConflict
+	}
+	if _, err := txn.Get(ctx, key); errors.Is(err, kv.ErrNotFound) {
+		return kv.ErrConflict
+	} else if err != nil {
+		return err
+	}
+
+	var ttl time.Duration
+	if !expiresAt.IsZero() {
+		if ttl = time.Until(expiresAt); ttl <= 0 {
+			return kv.ErrConflict
+		}
+	}
+	if err := txn.SetWithTTL(ctx, key, value, ttl); err != nil {
+		return err
+	}
+	return txn.Commit(ctx)
+}
+
+// isStale tells whether the stored value is not encrypted with the
+// current data key of its namespace.
+func (m *manager) isStale(ctx context.Context, key kv.Key, value kv.Value) (bool, error) {
+	if len(value) == 0 || value[0] != encodingEncrypted {
+		return true, nil
+	}
+	if len(value) < 5 {
+		return false, errUnknownEncoding
+	}
+
+	current, _, err := m.keys.currentKey(ctx, m.keys.namespace(key))
+	if err != nil {
+		return false, err
+	}
+	return binary.BigEndian.Uint32(value[1:]) != current, nil
+}
+
+// encrypt seals an encoded value with the current data key of the
+// namespace of the stored key.
+func (m *manager) encrypt(ctx context.Context, key kv.Key, data []byte) ([]byte, error) {
+	id, aead, err := m.keys.currentKey(ctx, m.keys.namespace(key))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, 5+aead.NonceSize()+len(data)+aead.Overhead())
+	out = append(out, encodingEncrypted)
+	out = binary.BigEndian.AppendUint32(out, id)
+	return seal(out, aead, data, key)
+}
+
+func (m *manager) decrypt(ctx context.Context, key kv.Key, value []byte) ([]byte, error) {
+	if m.keys == nil {
+		return nil, ErrEncryptionDisabled
+	}
+	if len(value) < 5 {
+		return nil, errUnknownEncoding
+	}
+
+	aead, err := m.keys.key(ctx, m.keys.namespace(key), binary.BigEndian.Uint32(value[1:]))
+	if err != nil {
+		return nil, err
+	}
+	return unseal(aead, value[5:], key)
+}
@@ -0,0 +1,276 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/mapkv"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func testMasterKeys(t *testing.T, ids ...uint32) *MasterKeys {
+	var keys []MasterKey
+	for _, id := range ids {
+		keys = append(keys, MasterKey{ID: id, Key: bytes.Repeat([]byte{byte(id)}, 32)})
+	}
+	mk, err := NewMasterKeys(keys)
+	require.NoError(t, err)
+	return mk
+}
+
+// dataKeyID returns the data key id of a stored encrypted value.
+func dataKeyID(t *testing.T, store kv.Store, key string) uint32 {
+	v, err := store.Get(context.Background(), wrapDataKey([]byte(key)))
+	require.NoError(t, err)
+	require.Equal(t, encodingEncrypted, v[0])
+	return binary.BigEndian.Uint32(v[1:])
+}
+
+func TestEncryption(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	newManager := func(cfg Config) Manager {
+		return New(cfg, Dependencies{
+			Store: store,
+			Log:   logrus.StandardLogger(),
+		})
+	}
+
+	ctx := context.Background()
+	mgr := newManager(Config{MasterKeys: testMasterKeys(t, 1), UseCompression: true})
+
+	err := newManager(Config{}).Set(ctx, []byte("tenant-a/plain"), []byte("plain value"))
+	require.NoError(t, err)
+	err = mgr.Set(ctx, []byte("tenant-a/key"), []byte("secret value"))
+	require.NoError(t, err)
+	err = mgr.Write(ctx, []Op{{Type: OpSet, Key: []byte("tenant-b/key"), Value: []byte("secret value")}})
+	require.NoError(t, err)
+
+	err = store.Scan(ctx, kv.ScanOptions{}, func(k kv.Key, v kv.Value) error {
+		require.NotContains(t, string(v), "secret", string(k))
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Every namespace has its own data key.
+	require.NotEqual(t, dataKeyID(t, store, "tenant-a/key"), dataKeyID(t, store, "tenant-b/key"))
+
+	// The data keys are kept in the store, a new manager reads them.
+	for _, m := range []Manager{mgr, newManager(Config{MasterKeys: testMasterKeys(t, 1)})} {
+		res, err := m.Get(ctx, []byte("tenant-a/key"))
+		require.NoError(t, err)
+		require.Equal(t, "secret value", res.Value)
+
+		list, err := m.Scan(ctx, ScanOptions{Prefix: "tenant-a/"})
+		require.NoError(t, err)
+		require.Equal(t, []KeyValuePair{
+			{Key: "tenant-a/key", Value: "secret value"},
+			{Key: "tenant-a/plain", Value: "plain value"},
+		}, list.List)
+	}
+
+	_, err = newManager(Config{}).Get(ctx, []byte("tenant-a/key"))
+	require.ErrorIs(t, err, ErrEncryptionDisabled)
+	_, err = newManager(Config{MasterKeys: testMasterKeys(t, 2)}).Get(ctx, []byte("tenant-a/key"))
+	require.ErrorIs(t, err, errUnknownKey)
+	_, err = newManager(Config{MasterKeys: testMasterKeys(t, 1)}).RotateKeys(ctx)
+	require.NoError(t, err)
+	_, err = newManager(Config{}).RotateKeys(ctx)
+	require.ErrorIs(t, err, ErrEncryptionDisabled)
+
+	// A value is bound to its key.
+	v, err := store.Get(ctx, wrapDataKey([]byte("tenant-a/key")))
+	require.NoError(t, err)
+	require.NoError(t, store.Set(ctx, wrapDataKey([]byte("tenant-a/copy")), v))
+	_, err = mgr.Get(ctx, []byte("tenant-a/copy"))
+	require.Error(t, err)
+}
+
+func TestEncryptionTxn(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{
+		MasterKeys: testMasterKeys(t, 1),
+	}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+
+	txn, err := mgr.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, txn.Set(ctx, []byte("ns/key"), []byte("value")))
+	res, err := txn.Get(ctx, []byte("ns/key"))
+	require.NoError(t, err)
+	require.Equal(t, "value", res.Value)
+	require.NoError(t, txn.Commit(ctx))
+
+	snap, err := mgr.Snapshot(ctx)
+	require.NoError(t, err)
+	defer snap.Close()
+	res, err = snap.Get(ctx, []byte("ns/key"))
+	require.NoError(t, err)
+	require.Equal(t, "value", res.Value)
+}
+
+func TestRotateKeys(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	newManager := func(cfg Config) Manager {
+		return New(cfg, Dependencies{
+			Store: store,
+			Log:   logrus.StandardLogger(),
+		})
+	}
+
+	ctx := context.Background()
+
+	err := newManager(Config{}).Set(ctx, []byte("a/plain"), []byte("plain"))
+	require.NoError(t, err)
+	mgr := newManager(Config{MasterKeys: testMasterKeys(t, 1)})
+	for _, key := range []string{"a/1", "a/2", "b/1"} {
+		require.NoError(t, mgr.Set(ctx, []byte(key), []byte("value "+key)))
+	}
+	oldID := dataKeyID(t, store, "a/1")
+
+	// A new master key wraps the data keys created by the rotation.
+	mgr = newManager(Config{MasterKeys: testMasterKeys(t, 1, 2), ReencryptOnRead: true})
+	n, err := mgr.RotateKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	require.NoError(t, mgr.Set(ctx, []byte("a/3"), []byte("value a/3")))
+	newID := dataKeyID(t, store, "a/3")
+	require.NotEqual(t, oldID, newID)
+
+	// Reading re-encrypts lazily.
+	res, err := mgr.Get(ctx, []byte("a/1"))
+	require.NoError(t, err)
+	require.Equal(t, "value a/1", res.Value)
+	require.Equal(t, newID, dataKeyID(t, store, "a/1"))
+
+	// The job re-encrypts the rest, including the values written before
+	// the encryption was enabled.
+	count, err := mgr.Reencrypt(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+	count, err = mgr.Reencrypt(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// The old master key is no longer needed.
+	list, err := newManager(Config{MasterKeys: testMasterKeys(t, 2)}).Scan(ctx, ScanOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []KeyValuePair{
+		{Key: "a/1", Value: "value a/1"},
+		{Key: "a/2", Value: "value a/2"},
+		{Key: "a/3", Value: "value a/3"},
+		{Key: "a/plain", Value: "plain"},
+		{Key: "b/1", Value: "value b/1"},
+	}, list.List)
+}
+
+func TestReencryptBatches(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{
+		MasterKeys: testMasterKeys(t, 1),
+	}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+
+	const keys = 2*reencryptBatchSize + 10
+	ops := make([]Op, 0, keys)
+	for i := 0; i < keys; i++ {
+		ops = append(ops, Op{Type: OpSet, Key: []byte{'k', byte(i >> 8), byte(i)}, Value: []byte("value")})
+	}
+	require.NoError(t, mgr.Write(ctx, ops))
+
+	_, err := mgr.RotateKeys(ctx)
+	require.NoError(t, err)
+	count, err := mgr.Reencrypt(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(keys), count)
+}
+
+func TestLoadKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	mk, err := LoadKeyFile(write("ok", `# rotated
+1 000102030405060708090a0b0c0d0e0f
+
+2 000102030405060708090a0b0c0d0e0f1011121314151617
+`))
+	require.NoError(t, err)
+	require.Len(t, mk.aeads, 2)
+	require.Equal(t, uint32(2), mk.current)
+
+	for name, content := range map[string]string{
+		"empty":     "# no keys\n",
+		"no-id":     "000102030405060708090a0b0c0d0e0f\n",
+		"bad-id":    "x 000102030405060708090a0b0c0d0e0f\n",
+		"bad-hex":   "1 not-hex\n",
+		"bad-size":  "1 0001\n",
+		"duplicate": "1 000102030405060708090a0b0c0d0e0f\n1 000102030405060708090a0b0c0d0e0f\n",
+	} {
+		_, err := LoadKeyFile(write(name, content))
+		require.Error(t, err, name)
+	}
+	_, err = LoadKeyFile(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
+
+func TestReencryptKeepsTTL(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{
+		MasterKeys:      testMasterKeys(t, 1),
+		ReencryptOnRead: true,
+	}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+	const ttl = 500 * time.Millisecond
+
+	require.NoError(t, mgr.SetWithTTL(ctx, []byte("a/read"), []byte("value"), ttl))
+	require.NoError(t, mgr.SetWithTTL(ctx, []byte("a/job"), []byte("value"), ttl))
+	oldID := dataKeyID(t, store, "a/read")
+
+	_, err := mgr.RotateKeys(ctx)
+	require.NoError(t, err)
+	_, err = mgr.Get(ctx, []byte("a/read"))
+	require.NoError(t, err)
+	count, err := mgr.Reencrypt(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	keys := []string{"a/read", "a/job"}
+	for _, key := range keys {
+		require.NotEqual(t, oldID, dataKeyID(t, store, key), key)
+	}
+	for _, key := range keys {
+		require.Eventually(t, func() bool {
+			_, err := mgr.Get(ctx, []byte(key))
+			return errors.Is(err, ErrNotFound)
+		}, 2*time.Second, 20*time.Millisecond, key)
+	}
+}
@@ -3,13 +3,36 @@ package manager
 import (
 	"context"
 	"errors"
+	"io"
 	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvdump"
+	"time"
 
 	"github.com/golang/snappy"
 	"github.com/sirupsen/logrus"
 )
 
 var ErrNotFound = kv.ErrNotFound
+var ErrUnknownOp = kv.ErrUnknownOp
+var ErrConflict = kv.ErrConflict
+var ErrTxnClosed = kv.ErrTxnClosed
+var ErrUnsupportedBackup = kv.ErrUnsupportedBackup
+var ErrInvalidDump = kvdump.ErrInvalidDump
+var ErrWatchLagged = kv.ErrWatchLagged
+
+type OpType = kv.OpType
+
+const (
+	OpSet    = kv.OpSet
+	OpDelete = kv.OpDelete
+)
+
+type Op struct {
+	Type  OpType
+	Key   []byte
+	Value []byte
+	TTL   time.Duration
+}
 
 type KeyValuePair struct {
 	Key   string
@@ -18,26 +41,68 @@ type KeyValuePair struct {
 
 type GetResult struct {
 	KeyValuePair
+	Version uint64
 }
 
 type ScanOptions struct {
 	Limit  int
 	Prefix string
+	// Start is the inclusive lower bound of the scan.
+	Start string
+	// End is the exclusive upper bound of the scan.
+	End     string
+	Reverse bool
+	// Cursor is the NextCursor of the previous page, the scan resumes
+	// strictly after the last key of it.
+	Cursor string
 }
 
 type ScanResult struct {
 	List []KeyValuePair
+	// NextCursor is set when the scan stopped at Limit, it fetches the
+	// next page when passed as Cursor with otherwise the same options.
+	NextCursor string
 }
 
 type Manager interface {
 	Set(_ context.Context, key []byte, value []byte) error
+	SetWithTTL(_ context.Context, key []byte, value []byte, ttl time.Duration) error
 	Get(_ context.Context, key []byte) (GetResult, error)
 	Delete(_ context.Context, key []byte) error
+	DeletePrefix(_ context.Context, prefix string, opts DeleteOptions) (int64, error)
+	DeleteRange(_ context.Context, start, end string, opts DeleteOptions) (int64, error)
+	SetIfVersion(_ context.Context, key []byte, value []byte, version uint64) error
+	SetIfAbsent(_ context.Context, key []byte, value []byte) error
+	DeleteIfVersion(_ context.Context, key []byte, version uint64) error
 	Scan(context.Context, ScanOptions) (ScanResult, error)
+	Write(context.Context, []Op) error
+	Begin(context.Context) (Txn, error)
+	Snapshot(context.Context) (Snapshot, error)
+	Backup(_ context.Context, w io.Writer, since uint64) (uint64, error)
+	Restore(_ context.Context, r io.Reader) error
+	Watch(_ context.Context, prefix string, fromVersion uint64, f func(Event) error) error
+	Count(_ context.Context, prefix string) (int64, error)
+	Stats(context.Context) (Stats, error)
+	Compact(context.Context) (int64, error)
+	RotateKeys(context.Context) (int, error)
+	Reencrypt(context.Context) (int64, error)
 }
 
 type Config struct {
+	// UseCompression compresses new values with snappy. Values written
+	// before the setting changed stay readable.
 	UseCompression bool
+	// MasterKeys enables the encryption of new values with AES-GCM. Every
+	// namespace has its own data keys, which are kept in the store
+	// wrapped by a master key. Values written before stay readable.
+	MasterKeys *MasterKeys
+	// NamespaceSeparator ends the namespace, the first part of a key,
+	// empty is "/". Keys without it share the empty namespace.
+	NamespaceSeparator string
+	// ReencryptOnRead rewrites the values returned by Get which are not
+	// encrypted with the current data key of their namespace. Rewritten
+	// values keep their expiry.
+	ReencryptOnRead bool
 }
 
 type Dependencies struct {
@@ -49,33 +114,40 @@ type manager struct {
 	deps Dependencies
 	cfg  Config
 
-	log *logrus.Entry
+	// keys is nil without encryption.
+	keys *keyring
+	log  *logrus.Entry
 }
 
 func New(cfg Config, deps Dependencies) Manager {
-	return &manager{
+	m := &manager{
 		deps: deps,
 		cfg:  cfg,
 		log:  deps.Log.WithField("component", "manager"),
 	}
+	if cfg.MasterKeys != nil {
+		m.keys = newKeyring(cfg.MasterKeys, cfg.NamespaceSeparator, deps.Store)
+	}
+	return m
 }
 
 func (m *manager) Set(ctx context.Context, key []byte, value []byte) error {
-	data := value
-	if m.cfg.UseCompression {
-		encodedLen := snappy.MaxEncodedLen(len(value))
-		data = make([]byte, encodedLen)
-		data = snappy.Encode(data, value)
-		m.log.Infof("data compressed %d -> %d", len(value), len(data))
-	}
+	return m.SetWithTTL(ctx, key, value, 0)
+}
+
+func (m *manager) SetWithTTL(ctx context.Context, key []byte, value []byte, ttl time.Duration) error {
 	key = wrapDataKey(key)
+	data, err := m.encodeValue(ctx, key, value)
+	if err != nil {
+		return err
+	}
 
-	return m.deps.Store.Set(ctx, key, data)
+	return m.deps.Store.SetWithTTL(ctx, key, data, ttl)
 }
 
 func (m *manager) Get(ctx context.Context, key []byte) (GetResult, error) {
 	key = wrapDataKey(key)
-	res, err := m.deps.Store.Get(ctx, key)
+	res, version, err := m.deps.Store.GetWithVersion(ctx, key)
 	if err != nil && !errors.Is(err, kv.ErrNotFound) {
 		m.log.Errorf("failed to get key=%s: %v", key, err)
 		return GetResult{}, err
@@ -83,17 +155,13 @@ func (m *manager) Get(ctx context.Context, key []byte) (GetResult, error) {
 		return GetResult{}, ErrNotFound
 	}
 
-	data := res
-	if m.cfg.UseCompression {
-		decodedLen, err := snappy.DecodedLen(res)
-		if err != nil {
-			return GetResult{}, err
-		}
-
-		data = make([]byte, decodedLen)
-		data, err = snappy.Decode(data, res)
-		if err != nil {
-			return GetResult{}, err
+	data, err := m.decodeValue(ctx, key, res)
+	if err != nil {
+		return GetResult{}, err
+	}
+	if m.cfg.ReencryptOnRead && m.keys != nil {
+		if _, err := m.reencryptValue(ctx, key, res, version); err != nil {
+			m.log.WithError(err).Warnf("failed to re-encrypt key=%s", key)
 		}
 	}
 	key, err = unwrapDataKey(key)
@@ -105,6 +173,7 @@ func (m *manager) Get(ctx context.Context, key []byte) (GetResult, error) {
 			Key:   string(key),
 			Value: string(data),
 		},
+		Version: version,
 	}, nil
 }
 
@@ -113,41 +182,224 @@ func (m *manager) Delete(ctx context.Context, key []byte) error {
 	return m.deps.Store.Delete(ctx, key)
 }
 
+func (m *manager) SetIfVersion(ctx context.Context, key []byte, value []byte, version uint64) error {
+	key = wrapDataKey(key)
+	data, err := m.encodeValue(ctx, key, value)
+	if err != nil {
+		return err
+	}
+
+	return m.deps.Store.SetIfVersion(ctx, key, data, version)
+}
+
+func (m *manager) SetIfAbsent(ctx context.Context, key []byte, value []byte) error {
+	key = wrapDataKey(key)
+	data, err := m.encodeValue(ctx, key, value)
+	if err != nil {
+		return err
+	}
+
+	return m.deps.Store.SetIfAbsent(ctx, key, data)
+}
+
+func (m *manager) DeleteIfVersion(ctx context.Context, key []byte, version uint64) error {
+	key = wrapDataKey(key)
+	return m.deps.Store.DeleteIfVersion(ctx, key, version)
+}
+
 func (m *manager) Scan(ctx context.Context, opts ScanOptions) (ScanResult, error) {
+	return m.scan(ctx, m.deps.Store.Scan, opts)
+}
+
+// scanFunc is a Scan method of either the store or a view of it.
+type scanFunc func(context.Context, kv.ScanOptions, kv.ScanHandler) error
+
+func (m *manager) scan(ctx context.Context, scan scanFunc, opts ScanOptions) (ScanResult, error) {
 	const preallocListSize = 64
 	list := make([]KeyValuePair, 0, preallocListSize)
-	err := m.deps.Store.Scan(ctx, kv.ScanOptions{
-		Prefix: wrapDataKey(kv.Key(opts.Prefix)),
-		Limit:  opts.Limit,
-	},
-		func(k kv.Key, v kv.Value) error {
-			data := v
-			key, err := unwrapDataKey(k)
-			if err != nil {
-				return err
-			}
-			if m.cfg.UseCompression {
-				decodedLen, err := snappy.DecodedLen(v)
-				if err != nil {
-					return err
-				}
-				data = make([]byte, decodedLen)
-				data, err = snappy.Decode(data, v)
-				if err != nil {
-					return err
-				}
-			}
-			list = append(list, KeyValuePair{
-				Key:   string(key),
-				Value: string(data),
-			})
-			return nil
-		})
+	scanOpts := kv.ScanOptions{
+		Prefix:  wrapDataKey(kv.Key(opts.Prefix)),
+		Limit:   opts.Limit,
+		Reverse: opts.Reverse,
+	}
+	if opts.Start != "" {
+		scanOpts.Start = wrapDataKey(kv.Key(opts.Start))
+	}
+	if opts.End != "" {
+		scanOpts.End = wrapDataKey(kv.Key(opts.End))
+	}
+	if opts.Cursor != "" {
+		after, err := decodeCursor(opts.Cursor)
+		if err != nil {
+			return ScanResult{}, err
+		}
+		scanOpts.After = wrapDataKey(after)
+	}
+	if opts.Limit > 0 {
+		// One more key tells whether there is a next page.
+		scanOpts.Limit = opts.Limit + 1
+	}
+
+	entries, err := m.scanRaw(ctx, scan, scanOpts)
 	if err != nil {
 		return ScanResult{}, err
 	}
+	for _, e := range entries {
+		key, err := unwrapDataKey(e.key)
+		if err != nil {
+			return ScanResult{}, err
+		}
+		data, err := m.decodeValue(ctx, e.key, e.value)
+		if err != nil {
+			return ScanResult{}, err
+		}
+		list = append(list, KeyValuePair{
+			Key:   string(key),
+			Value: string(data),
+		})
+	}
+
+	var next string
+	if opts.Limit > 0 && len(list) > opts.Limit {
+		list = list[:opts.Limit]
+		next = encodeCursor([]byte(list[len(list)-1].Key))
+	}
 
 	return ScanResult{
-		List: list,
+		List:       list,
+		NextCursor: next,
 	}, nil
 }
+
+type rawEntry struct {
+	key   kv.Key
+	value kv.Value
+}
+
+// scanRaw copies the stored entries, they are decoded once the scan is
+// over since decryption may read the store.
+func (m *manager) scanRaw(ctx context.Context, scan scanFunc, opts kv.ScanOptions) ([]rawEntry, error) {
+	var entries []rawEntry
+	err := scan(ctx, opts, func(k kv.Key, v kv.Value) error {
+		entries = append(entries, rawEntry{
+			key:   append(kv.Key(nil), k...),
+			value: append(kv.Value(nil), v...),
+		})
+		return nil
+	})
+	return entries, err
+}
+
+// getFunc is a Get method of either a transaction or a snapshot.
+type getFunc func(context.Context, kv.Key) (kv.Value, error)
+
+func (m *manager) get(ctx context.Context, get getFunc, key []byte) (GetResult, error) {
+	wrapped := wrapDataKey(key)
+	res, err := get(ctx, wrapped)
+	if err != nil && !errors.Is(err, kv.ErrNotFound) {
+		m.log.Errorf("failed to get key=%s: %v", key, err)
+		return GetResult{}, err
+	} else if errors.Is(err, kv.ErrNotFound) {
+		return GetResult{}, ErrNotFound
+	}
+
+	data, err := m.decodeValue(ctx, wrapped, res)
+	if err != nil {
+		return GetResult{}, err
+	}
+
+	return GetResult{
+		KeyValuePair: KeyValuePair{
+			Key:   string(key),
+			Value: string(data),
+		},
+	}, nil
+}
+
+func (m *manager) Write(ctx context.Context, ops []Op) error {
+	batch := make([]kv.Op, 0, len(ops))
+	for _, op := range ops {
+		o := kv.Op{
+			Type: op.Type,
+			Key:  wrapDataKey(op.Key),
+			TTL:  op.TTL,
+		}
+		if op.Type == OpSet {
+			var err error
+			if o.Value, err = m.encodeValue(ctx, o.Key, op.Value); err != nil {
+				return err
+			}
+		}
+		batch = append(batch, o)
+	}
+
+	return m.deps.Store.Write(ctx, batch)
+}
+
+// Stored values start with a byte describing their encoding, so values
+// written with and without compression or encryption can be read back
+// whatever the current configuration is. Encrypted values hold a data
+// key id, a nonce and the sealed raw or compressed value.
+const (
+	encodingRaw       byte = 0
+	encodingSnappy    byte = 1
+	encodingEncrypted byte = 2
+)
+
+var errUnknownEncoding = errors.New("unknown value encoding")
+
+// encodeValue encodes the value of the stored key, which the encryption
+// binds it to.
+func (m *manager) encodeValue(ctx context.Context, key kv.Key, value []byte) ([]byte, error) {
+	data := m.compress(value)
+	if m.keys == nil {
+		return data, nil
+	}
+	return m.encrypt(ctx, key, data)
+}
+
+func (m *manager) compress(value []byte) []byte {
+	if !m.cfg.UseCompression {
+		return append([]byte{encodingRaw}, value...)
+	}
+
+	data := make([]byte, 1+snappy.MaxEncodedLen(len(value)))
+	data[0] = encodingSnappy
+	encoded := snappy.Encode(data[1:], value)
+	m.log.Debugf("data compressed %d -> %d", len(value), len(encoded))
+	return data[:1+len(encoded)]
+}
+
+func (m *manager) decodeValue(ctx context.Context, key kv.Key, value []byte) ([]byte, error) {
+	if len(value) > 0 && value[0] == encodingEncrypted {
+		var err error
+		if value, err = m.decrypt(ctx, key, value); err != nil {
+			return nil, err
+		}
+		if len(value) > 0 && value[0] == encodingEncrypted {
+			return nil, errUnknownEncoding
+		}
+	}
+	return m.decompress(value)
+}
+
+func (m *manager) decompress(value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return nil, errUnknownEncoding
+	}
+
+	switch value[0] {
+	case encodingRaw:
+		return value[1:], nil
+	case encodingSnappy:
+		decodedLen, err := snappy.DecodedLen(value[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		data := make([]byte, decodedLen)
+		return snappy.Decode(data, value[1:])
+	}
+
+	return nil, errUnknownEncoding
+}
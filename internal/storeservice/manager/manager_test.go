@@ -2,10 +2,10 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"kvstore/internal/storeservice/store/mapkv"
-	"sort"
-	"strings"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
@@ -68,6 +68,37 @@ func TestSetGet(t *testing.T) {
 	}
 }
 
+func TestToggleCompression(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	newManager := func(useCompression bool) Manager {
+		return New(Config{
+			UseCompression: useCompression,
+		}, Dependencies{
+			Store: store,
+			Log:   logrus.StandardLogger(),
+		})
+	}
+
+	ctx := context.Background()
+
+	err := newManager(true).Set(ctx, []byte("compressed"), []byte("value1"))
+	require.NoError(t, err)
+	err = newManager(false).Set(ctx, []byte("raw"), []byte("value2"))
+	require.NoError(t, err)
+
+	// Values stay readable after the setting changes.
+	for _, mgr := range []Manager{newManager(true), newManager(false)} {
+		res, err := mgr.Get(ctx, []byte("compressed"))
+		require.NoError(t, err)
+		require.Equal(t, "value1", res.Value)
+
+		res, err = mgr.Get(ctx, []byte("raw"))
+		require.NoError(t, err)
+		require.Equal(t, "value2", res.Value)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	var (
 		value = []byte("test-value")
@@ -93,11 +124,220 @@ func TestDelete(t *testing.T) {
 
 }
 
+func TestSetWithTTL(t *testing.T) {
+	var (
+		value = []byte("test-value")
+		key   = []byte("test-key")
+	)
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{},
+		Dependencies{
+			Store: store,
+			Log:   logrus.StandardLogger(),
+		})
+
+	ctx := context.Background()
+
+	err := mgr.SetWithTTL(ctx, key, value, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	res, err := mgr.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, string(value), res.Value)
+
+	require.Eventually(t, func() bool {
+		_, err := mgr.Get(ctx, key)
+		return errors.Is(err, ErrNotFound)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestConditionalWrites(t *testing.T) {
+	var key = []byte("test-key")
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{},
+		Dependencies{
+			Store: store,
+			Log:   logrus.StandardLogger(),
+		})
+
+	ctx := context.Background()
+
+	err := mgr.SetIfAbsent(ctx, key, []byte("v1"))
+	require.NoError(t, err)
+	err = mgr.SetIfAbsent(ctx, key, []byte("v1"))
+	require.ErrorIs(t, err, ErrConflict)
+
+	res, err := mgr.Get(ctx, key)
+	require.NoError(t, err)
+
+	err = mgr.SetIfVersion(ctx, key, []byte("v2"), res.Version+1)
+	require.ErrorIs(t, err, ErrConflict)
+	err = mgr.SetIfVersion(ctx, key, []byte("v2"), res.Version)
+	require.NoError(t, err)
+
+	err = mgr.DeleteIfVersion(ctx, key, res.Version)
+	require.ErrorIs(t, err, ErrConflict)
+
+	res, err = mgr.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, "v2", res.Value)
+
+	err = mgr.DeleteIfVersion(ctx, key, res.Version)
+	require.NoError(t, err)
+
+	_, err = mgr.Get(ctx, key)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTxn(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{
+		UseCompression: true,
+	}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+
+	err := mgr.Set(ctx, []byte("key"), []byte("value"))
+	require.NoError(t, err)
+
+	txn, err := mgr.Begin(ctx)
+	require.NoError(t, err)
+
+	res, err := txn.Get(ctx, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, "value", res.Value)
+
+	err = txn.Set(ctx, []byte("key"), []byte("new-value"))
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	res, err = mgr.Get(ctx, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, "new-value", res.Value)
+}
+
+func TestSnapshot(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{
+		UseCompression: true,
+	}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+
+	err := mgr.Set(ctx, []byte("key-1"), []byte("value-1"))
+	require.NoError(t, err)
+
+	snap, err := mgr.Snapshot(ctx)
+	require.NoError(t, err)
+	defer snap.Close()
+
+	err = mgr.Set(ctx, []byte("key-1"), []byte("value-2"))
+	require.NoError(t, err)
+	err = mgr.Set(ctx, []byte("key-2"), []byte("value-2"))
+	require.NoError(t, err)
+
+	res, err := snap.Get(ctx, []byte("key-1"))
+	require.NoError(t, err)
+	require.Equal(t, "value-1", res.Value)
+
+	list, err := snap.Scan(ctx, ScanOptions{})
+	require.NoError(t, err)
+	require.Equal(t, ScanResult{
+		List: []KeyValuePair{
+			{Key: "key-1", Value: "value-1"},
+		},
+	}, list)
+}
+
+func TestWatch(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{
+		UseCompression: true,
+	}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := mgr.Set(ctx, []byte("other"), []byte("value"))
+	require.NoError(t, err)
+	err = mgr.Set(ctx, []byte("key-1"), []byte("value-1"))
+	require.NoError(t, err)
+	res, err := mgr.Get(ctx, []byte("key-1"))
+	require.NoError(t, err)
+
+	events := make(chan Event, 10)
+	go mgr.Watch(ctx, "key-", res.Version-1, func(e Event) error {
+		events <- e
+		return nil
+	})
+
+	// The replayed key shows the watch has started.
+	require.Equal(t, Event{Type: EventPut, Key: "key-1", Value: "value-1", Version: res.Version}, <-events)
+
+	err = mgr.Set(ctx, []byte("other"), []byte("value"))
+	require.NoError(t, err)
+	err = mgr.Delete(ctx, []byte("key-1"))
+	require.NoError(t, err)
+
+	e := <-events
+	require.Equal(t, EventDelete, e.Type)
+	require.Equal(t, "key-1", e.Key)
+	require.Empty(t, e.Value)
+}
+
+func TestWrite(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{
+		UseCompression: true,
+	}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+
+	err := mgr.Set(ctx, []byte("key-old"), []byte("old"))
+	require.NoError(t, err)
+
+	err = mgr.Write(ctx, []Op{
+		{Type: OpSet, Key: []byte("key-1"), Value: []byte("value-1")},
+		{Type: OpSet, Key: []byte("key-2"), Value: []byte("value-2")},
+		{Type: OpDelete, Key: []byte("key-old")},
+	})
+	require.NoError(t, err)
+
+	res, err := mgr.Scan(ctx, ScanOptions{})
+	require.NoError(t, err)
+	require.Equal(t, ScanResult{
+		List: []KeyValuePair{
+			{Key: "key-1", Value: "value-1"},
+			{Key: "key-2", Value: "value-2"},
+		},
+	}, res)
+}
+
 func TestScan(t *testing.T) {
 	var value = []byte("test-value")
 	type test struct {
 		name           string
 		input          []KeyValuePair
+		opts           ScanOptions
 		result         ScanResult
 		useCompression bool
 	}
@@ -130,6 +370,27 @@ func TestScan(t *testing.T) {
 			},
 			useCompression: true,
 		},
+		{
+			name: "range_reverse",
+			input: []KeyValuePair{
+				{Key: "key-1", Value: string(value)},
+				{Key: "key-2", Value: string(value)},
+				{Key: "key-3", Value: string(value)},
+				{Key: "key-4", Value: string(value)},
+			},
+			opts: ScanOptions{
+				Prefix:  "key-",
+				Start:   "key-2",
+				End:     "key-4",
+				Reverse: true,
+			},
+			result: ScanResult{
+				List: []KeyValuePair{
+					{Key: "key-3", Value: string(value)},
+					{Key: "key-2", Value: string(value)},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -149,18 +410,130 @@ func TestScan(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			res, err := mgr.Scan(ctx, ScanOptions{})
+			res, err := mgr.Scan(ctx, c.opts)
 			require.NoError(t, err)
+			require.Equal(t, c.result, res)
+		})
+	}
+}
 
-			sort.Slice(res.List, func(i, j int) bool {
-				return strings.Compare(res.List[i].Key, res.List[j].Key) < 0
-			})
+func TestScanCursor(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
 
-			sort.Slice(c.result.List, func(i, j int) bool {
-				return strings.Compare(c.result.List[i].Key, c.result.List[j].Key) < 0
-			})
+	ctx := context.Background()
 
-			require.Equal(t, c.result, res)
-		})
+	for _, key := range []string{"key-1", "key-2", "key-3", "key-4", "key-5", "other"} {
+		err := mgr.Set(ctx, []byte(key), []byte("value"))
+		require.NoError(t, err)
+	}
+
+	pages := func(opts ScanOptions) [][]string {
+		var pages [][]string
+		for {
+			res, err := mgr.Scan(ctx, opts)
+			require.NoError(t, err)
+
+			var keys []string
+			for _, kv := range res.List {
+				keys = append(keys, kv.Key)
+			}
+			pages = append(pages, keys)
+
+			if res.NextCursor == "" {
+				return pages
+			}
+			opts.Cursor = res.NextCursor
+		}
+	}
+
+	require.Equal(t, [][]string{
+		{"key-1", "key-2"},
+		{"key-3", "key-4"},
+		{"key-5"},
+	}, pages(ScanOptions{Prefix: "key-", Limit: 2}))
+
+	require.Equal(t, [][]string{
+		{"key-5", "key-4", "key-3"},
+		{"key-2", "key-1"},
+	}, pages(ScanOptions{Prefix: "key-", Limit: 3, Reverse: true}))
+
+	// A page ending exactly at the last key is not truncated.
+	require.Equal(t, [][]string{
+		{"key-1", "key-2", "key-3", "key-4", "key-5"},
+	}, pages(ScanOptions{Prefix: "key-", Limit: 5}))
+
+	_, err := mgr.Scan(ctx, ScanOptions{Cursor: "not a cursor"})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestCount(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+
+	for _, key := range []string{"key-1", "key-2", "other"} {
+		err := mgr.Set(ctx, []byte(key), []byte("value"))
+		require.NoError(t, err)
 	}
+
+	count, err := mgr.Count(ctx, "key-")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	count, err = mgr.Count(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+}
+
+func TestDeletePrefix(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	mgr := New(Config{}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	})
+
+	ctx := context.Background()
+
+	for _, key := range []string{"key-1", "key-2", "key-3", "other"} {
+		err := mgr.Set(ctx, []byte(key), []byte("value"))
+		require.NoError(t, err)
+	}
+
+	_, err := mgr.DeletePrefix(ctx, "", DeleteOptions{})
+	require.ErrorIs(t, err, ErrEmptyPrefix)
+	_, err = mgr.DeleteRange(ctx, "", "", DeleteOptions{DryRun: true})
+	require.ErrorIs(t, err, ErrEmptyPrefix)
+
+	count, err := mgr.DeletePrefix(ctx, "key-", DeleteOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+	count, err = mgr.DeleteRange(ctx, "key-2", "", DeleteOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	count, err = mgr.DeleteRange(ctx, "", "key-2", DeleteOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	count, err = mgr.DeletePrefix(ctx, "key-", DeleteOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	res, err := mgr.Scan(ctx, ScanOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []KeyValuePair{{Key: "other", Value: "value"}}, res.List)
+
+	count, err = mgr.DeletePrefix(ctx, "", DeleteOptions{All: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
 }
@@ -0,0 +1,287 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"kvstore/internal/storeservice/opmetrics"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// instrumented records the metrics of the operations of a manager, the
+// value sizes are those of the decoded values.
+type instrumented struct {
+	m       Manager
+	metrics *opmetrics.Metrics
+}
+
+// Instrument wraps the manager to record the latency, error class,
+// value sizes and scan rows of its operations in the registry. The
+// names of the metrics start with manager_.
+func Instrument(m Manager, reg prometheus.Registerer) (Manager, error) {
+	i := &instrumented{
+		m:       m,
+		metrics: opmetrics.New("manager", errorClass),
+	}
+	if err := reg.Register(i.metrics); err != nil {
+		return nil, fmt.Errorf("register manager metrics: %w", err)
+	}
+	return i, nil
+}
+
+func errorClass(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidCursor),
+		errors.Is(err, ErrEmptyPrefix),
+		errors.Is(err, ErrEncryptionDisabled):
+		return opmetrics.ClassInvalid
+	}
+	return opmetrics.ErrorClass(err)
+}
+
+func (i *instrumented) Set(ctx context.Context, key []byte, value []byte) error {
+	i.metrics.ValueSize("set", len(value))
+	end := i.metrics.Start("set")
+	err := i.m.Set(ctx, key, value)
+	end(err)
+	return err
+}
+
+func (i *instrumented) SetWithTTL(ctx context.Context, key []byte, value []byte, ttl time.Duration) error {
+	i.metrics.ValueSize("set_with_ttl", len(value))
+	end := i.metrics.Start("set_with_ttl")
+	err := i.m.SetWithTTL(ctx, key, value, ttl)
+	end(err)
+	return err
+}
+
+func (i *instrumented) Get(ctx context.Context, key []byte) (GetResult, error) {
+	end := i.metrics.Start("get")
+	res, err := i.m.Get(ctx, key)
+	end(err)
+	if err == nil {
+		i.metrics.ValueSize("get", len(res.Value))
+	}
+	return res, err
+}
+
+func (i *instrumented) Delete(ctx context.Context, key []byte) error {
+	end := i.metrics.Start("delete")
+	err := i.m.Delete(ctx, key)
+	end(err)
+	return err
+}
+
+func (i *instrumented) DeletePrefix(ctx context.Context, prefix string, opts DeleteOptions) (int64, error) {
+	end := i.metrics.Start("delete_prefix")
+	n, err := i.m.DeletePrefix(ctx, prefix, opts)
+	end(err)
+	return n, err
+}
+
+func (i *instrumented) DeleteRange(ctx context.Context, start, endKey string, opts DeleteOptions) (int64, error) {
+	end := i.metrics.Start("delete_range")
+	n, err := i.m.DeleteRange(ctx, start, endKey, opts)
+	end(err)
+	return n, err
+}
+
+func (i *instrumented) SetIfVersion(ctx context.Context, key []byte, value []byte, version uint64) error {
+	i.metrics.ValueSize("set_if_version", len(value))
+	end := i.metrics.Start("set_if_version")
+	err := i.m.SetIfVersion(ctx, key, value, version)
+	end(err)
+	return err
+}
+
+func (i *instrumented) SetIfAbsent(ctx context.Context, key []byte, value []byte) error {
+	i.metrics.ValueSize("set_if_absent", len(value))
+	end := i.metrics.Start("set_if_absent")
+	err := i.m.SetIfAbsent(ctx, key, value)
+	end(err)
+	return err
+}
+
+func (i *instrumented) DeleteIfVersion(ctx context.Context, key []byte, version uint64) error {
+	end := i.metrics.Start("delete_if_version")
+	err := i.m.DeleteIfVersion(ctx, key, version)
+	end(err)
+	return err
+}
+
+func (i *instrumented) Scan(ctx context.Context, opts ScanOptions) (ScanResult, error) {
+	end := i.metrics.Start("scan")
+	res, err := i.m.Scan(ctx, opts)
+	end(err)
+	if err == nil {
+		i.metrics.ScanRows("scan", len(res.List))
+	}
+	return res, err
+}
+
+func (i *instrumented) Write(ctx context.Context, ops []Op) error {
+	for _, op := range ops {
+		if op.Type == OpSet {
+			i.metrics.ValueSize("write", len(op.Value))
+		}
+	}
+	end := i.metrics.Start("write")
+	err := i.m.Write(ctx, ops)
+	end(err)
+	return err
+}
+
+func (i *instrumented) Begin(ctx context.Context) (Txn, error) {
+	end := i.metrics.Start("begin")
+	t, err := i.m.Begin(ctx)
+	end(err)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedTxn{txn: t, metrics: i.metrics}, nil
+}
+
+func (i *instrumented) Snapshot(ctx context.Context) (Snapshot, error) {
+	end := i.metrics.Start("snapshot")
+	snap, err := i.m.Snapshot(ctx)
+	end(err)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedSnapshot{snap: snap, metrics: i.metrics}, nil
+}
+
+func (i *instrumented) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	end := i.metrics.Start("backup")
+	version, err := i.m.Backup(ctx, w, since)
+	end(err)
+	return version, err
+}
+
+func (i *instrumented) Restore(ctx context.Context, r io.Reader) error {
+	end := i.metrics.Start("restore")
+	err := i.m.Restore(ctx, r)
+	end(err)
+	return err
+}
+
+// Watch counts the errors of watches only, their duration is up to the
+// watcher. A watch ended by its context is not an error.
+func (i *instrumented) Watch(ctx context.Context, prefix string, fromVersion uint64, f func(Event) error) error {
+	err := i.m.Watch(ctx, prefix, fromVersion, f)
+	if ctx.Err() == nil {
+		i.metrics.Error("watch", err)
+	}
+	return err
+}
+
+func (i *instrumented) Count(ctx context.Context, prefix string) (int64, error) {
+	end := i.metrics.Start("count")
+	n, err := i.m.Count(ctx, prefix)
+	end(err)
+	return n, err
+}
+
+func (i *instrumented) Stats(ctx context.Context) (Stats, error) {
+	end := i.metrics.Start("stats")
+	stats, err := i.m.Stats(ctx)
+	end(err)
+	return stats, err
+}
+
+func (i *instrumented) Compact(ctx context.Context) (int64, error) {
+	end := i.metrics.Start("compact")
+	n, err := i.m.Compact(ctx)
+	end(err)
+	return n, err
+}
+
+func (i *instrumented) RotateKeys(ctx context.Context) (int, error) {
+	end := i.metrics.Start("rotate_keys")
+	n, err := i.m.RotateKeys(ctx)
+	end(err)
+	return n, err
+}
+
+func (i *instrumented) Reencrypt(ctx context.Context) (int64, error) {
+	end := i.metrics.Start("reencrypt")
+	n, err := i.m.Reencrypt(ctx)
+	end(err)
+	return n, err
+}
+
+type instrumentedTxn struct {
+	txn     Txn
+	metrics *opmetrics.Metrics
+}
+
+func (t *instrumentedTxn) Get(ctx context.Context, key []byte) (GetResult, error) {
+	end := t.metrics.Start("txn_get")
+	res, err := t.txn.Get(ctx, key)
+	end(err)
+	if err == nil {
+		t.metrics.ValueSize("txn_get", len(res.Value))
+	}
+	return res, err
+}
+
+func (t *instrumentedTxn) Set(ctx context.Context, key []byte, value []byte) error {
+	t.metrics.ValueSize("txn_set", len(value))
+	end := t.metrics.Start("txn_set")
+	err := t.txn.Set(ctx, key, value)
+	end(err)
+	return err
+}
+
+func (t *instrumentedTxn) Delete(ctx context.Context, key []byte) error {
+	end := t.metrics.Start("txn_delete")
+	err := t.txn.Delete(ctx, key)
+	end(err)
+	return err
+}
+
+func (t *instrumentedTxn) Commit(ctx context.Context) error {
+	end := t.metrics.Start("txn_commit")
+	err := t.txn.Commit(ctx)
+	end(err)
+	return err
+}
+
+func (t *instrumentedTxn) Rollback() error {
+	end := t.metrics.Start("txn_rollback")
+	err := t.txn.Rollback()
+	end(err)
+	return err
+}
+
+type instrumentedSnapshot struct {
+	snap    Snapshot
+	metrics *opmetrics.Metrics
+}
+
+func (s *instrumentedSnapshot) Get(ctx context.Context, key []byte) (GetResult, error) {
+	end := s.metrics.Start("snapshot_get")
+	res, err := s.snap.Get(ctx, key)
+	end(err)
+	if err == nil {
+		s.metrics.ValueSize("snapshot_get", len(res.Value))
+	}
+	return res, err
+}
+
+func (s *instrumentedSnapshot) Scan(ctx context.Context, opts ScanOptions) (ScanResult, error) {
+	end := s.metrics.Start("snapshot_scan")
+	res, err := s.snap.Scan(ctx, opts)
+	end(err)
+	if err == nil {
+		s.metrics.ScanRows("snapshot_scan", len(res.List))
+	}
+	return res, err
+}
+
+func (s *instrumentedSnapshot) Close() error {
+	return s.snap.Close()
+}
@@ -0,0 +1,75 @@
+package manager
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/mapkv"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrument(t *testing.T) {
+	store := mapkv.NewStore()
+	defer store.Close()
+	reg := prometheus.NewRegistry()
+	mgr, err := Instrument(New(Config{}, Dependencies{
+		Store: store,
+		Log:   logrus.StandardLogger(),
+	}), reg)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, mgr.Set(ctx, []byte("a"), []byte("value")))
+	require.NoError(t, mgr.Set(ctx, []byte("b"), []byte("value")))
+	res, err := mgr.Get(ctx, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, "value", res.Value)
+	_, err = mgr.Get(ctx, []byte("missing"))
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = mgr.DeletePrefix(ctx, "", DeleteOptions{})
+	require.ErrorIs(t, err, ErrEmptyPrefix)
+	_, err = mgr.Scan(ctx, ScanOptions{Cursor: "not a cursor"})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+	list, err := mgr.Scan(ctx, ScanOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.List, 2)
+
+	txn, err := mgr.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, txn.Set(ctx, []byte("c"), []byte("value")))
+	require.NoError(t, txn.Commit(ctx))
+	require.ErrorIs(t, txn.Commit(ctx), ErrTxnClosed)
+
+	expected := `
+# HELP manager_operation_errors_total Failed operations by error class.
+# TYPE manager_operation_errors_total counter
+manager_operation_errors_total{class="closed",op="txn_commit"} 1
+manager_operation_errors_total{class="invalid",op="delete_prefix"} 1
+manager_operation_errors_total{class="invalid",op="scan"} 1
+manager_operation_errors_total{class="not_found",op="get"} 1
+# HELP manager_scan_rows Rows returned by a scan.
+# TYPE manager_scan_rows histogram
+manager_scan_rows_bucket{op="scan",le="1"} 0
+manager_scan_rows_bucket{op="scan",le="4"} 1
+manager_scan_rows_bucket{op="scan",le="16"} 1
+manager_scan_rows_bucket{op="scan",le="64"} 1
+manager_scan_rows_bucket{op="scan",le="256"} 1
+manager_scan_rows_bucket{op="scan",le="1024"} 1
+manager_scan_rows_bucket{op="scan",le="4096"} 1
+manager_scan_rows_bucket{op="scan",le="16384"} 1
+manager_scan_rows_bucket{op="scan",le="65536"} 1
+manager_scan_rows_bucket{op="scan",le="262144"} 1
+manager_scan_rows_bucket{op="scan",le="+Inf"} 1
+manager_scan_rows_sum{op="scan"} 2
+manager_scan_rows_count{op="scan"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"manager_operation_errors_total", "manager_scan_rows"))
+
+	_, err = Instrument(mgr, reg)
+	require.Error(t, err)
+}
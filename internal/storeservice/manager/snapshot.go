@@ -0,0 +1,43 @@
+package manager
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+)
+
+// Snapshot is a consistent read-only view of the data. It must be
+// released with Close.
+type Snapshot interface {
+	Get(_ context.Context, key []byte) (GetResult, error)
+	Scan(context.Context, ScanOptions) (ScanResult, error)
+	Close() error
+}
+
+type snapshot struct {
+	m    *manager
+	snap kv.Snapshot
+}
+
+func (m *manager) Snapshot(ctx context.Context) (Snapshot, error) {
+	snap, err := m.deps.Store.Snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot{
+		m:    m,
+		snap: snap,
+	}, nil
+}
+
+func (s *snapshot) Get(ctx context.Context, key []byte) (GetResult, error) {
+	return s.m.get(ctx, s.snap.Get, key)
+}
+
+func (s *snapshot) Scan(ctx context.Context, opts ScanOptions) (ScanResult, error) {
+	return s.m.scan(ctx, s.snap.Scan, opts)
+}
+
+func (s *snapshot) Close() error {
+	return s.snap.Close()
+}
@@ -0,0 +1,18 @@
+package manager
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+)
+
+type Stats = kv.Stats
+
+func (m *manager) Count(ctx context.Context, prefix string) (int64, error) {
+	return m.deps.Store.Count(ctx, wrapDataKey([]byte(prefix)))
+}
+
+// Stats describes the store as is, the sizes include the key prefix and
+// the encoding of the values.
+func (m *manager) Stats(ctx context.Context) (Stats, error) {
+	return m.deps.Store.Stats(ctx)
+}
@@ -0,0 +1,56 @@
+package manager
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+)
+
+type Txn interface {
+	Get(_ context.Context, key []byte) (GetResult, error)
+	Set(_ context.Context, key []byte, value []byte) error
+	Delete(_ context.Context, key []byte) error
+	Commit(context.Context) error
+	Rollback() error
+}
+
+type txn struct {
+	m   *manager
+	txn kv.Txn
+}
+
+func (m *manager) Begin(ctx context.Context) (Txn, error) {
+	t, err := m.deps.Store.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txn{
+		m:   m,
+		txn: t,
+	}, nil
+}
+
+func (t *txn) Get(ctx context.Context, key []byte) (GetResult, error) {
+	return t.m.get(ctx, t.txn.Get, key)
+}
+
+func (t *txn) Set(ctx context.Context, key []byte, value []byte) error {
+	key = wrapDataKey(key)
+	data, err := t.m.encodeValue(ctx, key, value)
+	if err != nil {
+		return err
+	}
+	return t.txn.Set(ctx, key, data)
+}
+
+func (t *txn) Delete(ctx context.Context, key []byte) error {
+	return t.txn.Delete(ctx, wrapDataKey(key))
+}
+
+func (t *txn) Commit(ctx context.Context) error {
+	return t.txn.Commit(ctx)
+}
+
+func (t *txn) Rollback() error {
+	return t.txn.Rollback()
+}
@@ -0,0 +1,46 @@
+package manager
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+)
+
+type EventType = kv.EventType
+
+const (
+	EventPut    = kv.EventPut
+	EventDelete = kv.EventDelete
+)
+
+// Event is a change of a key, Value is empty for EventDelete.
+type Event struct {
+	Type    EventType
+	Key     string
+	Value   string
+	Version uint64
+}
+
+// Watch calls f with the changes of the keys with the prefix until ctx
+// is done or f fails, see kv.Store.Watch.
+func (m *manager) Watch(ctx context.Context, prefix string, fromVersion uint64, f func(Event) error) error {
+	return m.deps.Store.Watch(ctx, wrapDataKey([]byte(prefix)), fromVersion, func(e kv.Event) error {
+		key, err := unwrapDataKey(e.Key)
+		if err != nil {
+			return err
+		}
+
+		event := Event{
+			Type:    e.Type,
+			Key:     string(key),
+			Version: e.Version,
+		}
+		if e.Type == kv.EventPut {
+			data, err := m.decodeValue(ctx, e.Key, e.Value)
+			if err != nil {
+				return err
+			}
+			event.Value = string(data)
+		}
+		return f(event)
+	})
+}
@@ -0,0 +1,134 @@
+// Package opmetrics records the latency, errors, value sizes and scan
+// rows of the operations of a store layer.
+package opmetrics
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvdump"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Error classes, the label values of the error counter.
+const (
+	ClassNotFound = "not_found"
+	ClassConflict = "conflict"
+	ClassInvalid  = "invalid"
+	ClassCanceled = "canceled"
+	ClassTimeout  = "timeout"
+	ClassClosed   = "closed"
+	ClassLagged   = "lagged"
+	ClassInternal = "internal"
+)
+
+// ErrorClass classifies the errors of kv.Store, anything it does not
+// know is internal.
+func ErrorClass(err error) string {
+	switch {
+	case errors.Is(err, kv.ErrNotFound):
+		return ClassNotFound
+	case errors.Is(err, kv.ErrConflict):
+		return ClassConflict
+	case errors.Is(err, kv.ErrUnknownOp),
+		errors.Is(err, kv.ErrUnsupportedBackup),
+		errors.Is(err, kv.ErrCompactUnsupported),
+		errors.Is(err, kvdump.ErrInvalidDump):
+		return ClassInvalid
+	case errors.Is(err, context.Canceled):
+		return ClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ClassTimeout
+	case errors.Is(err, kv.ErrTxnClosed), errors.Is(err, kv.ErrClosed):
+		return ClassClosed
+	case errors.Is(err, kv.ErrWatchLagged):
+		return ClassLagged
+	}
+	return ClassInternal
+}
+
+// Metrics is a prometheus.Collector of the metrics of one layer, the
+// names of its metrics start with the subsystem.
+type Metrics struct {
+	classify  func(error) string
+	duration  *prometheus.HistogramVec
+	errors    *prometheus.CounterVec
+	valueSize *prometheus.HistogramVec
+	scanRows  *prometheus.HistogramVec
+}
+
+// New returns the metrics of the subsystem. classify maps the errors to
+// their class, nil uses ErrorClass.
+func New(subsystem string, classify func(error) string) *Metrics {
+	if classify == nil {
+		classify = ErrorClass
+	}
+	return &Metrics{
+		classify: classify,
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the operations.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, []string{"op"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "operation_errors_total",
+			Help:      "Failed operations by error class.",
+		}, []string{"op", "class"}),
+		valueSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "value_size_bytes",
+			Help:      "Size of the values read and written.",
+			Buckets:   prometheus.ExponentialBuckets(16, 4, 10),
+		}, []string{"op"}),
+		scanRows: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      "scan_rows",
+			Help:      "Rows returned by a scan.",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+		}, []string{"op"}),
+	}
+}
+
+// Start times an operation, the returned func records it with the
+// error it ended with.
+func (m *Metrics) Start(op string) func(error) {
+	start := time.Now()
+	return func(err error) {
+		m.duration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+		m.Error(op, err)
+	}
+}
+
+// Error counts a failed operation, nil err is not counted. It is meant
+// for long running operations like watches whose duration says nothing.
+func (m *Metrics) Error(op string, err error) {
+	if err != nil {
+		m.errors.WithLabelValues(op, m.classify(err)).Inc()
+	}
+}
+
+func (m *Metrics) ValueSize(op string, size int) {
+	m.valueSize.WithLabelValues(op).Observe(float64(size))
+}
+
+func (m *Metrics) ScanRows(op string, rows int) {
+	m.scanRows.WithLabelValues(op).Observe(float64(rows))
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.duration.Describe(ch)
+	m.errors.Describe(ch)
+	m.valueSize.Describe(ch)
+	m.scanRows.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.duration.Collect(ch)
+	m.errors.Collect(ch)
+	m.valueSize.Collect(ch)
+	m.scanRows.Collect(ch)
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"kvstore/internal/protobuf/storepb"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+// backupChunkSize keeps backup messages well below the gRPC message
+// size limit.
+const backupChunkSize = 256 << 10
+
+type AdminServer struct {
+	deps Dependencies
+	log  *logrus.Entry
+
+	// reencrypting is set while a background re-encryption runs.
+	reencrypting atomic.Bool
+
+	storepb.UnimplementedAdminServer
+}
+
+func (a *AdminServer) Backup(req *storepb.BackupRequest, stream storepb.Admin_BackupServer) error {
+	w := bufio.NewWriterSize(&chunkWriter{stream: stream}, backupChunkSize)
+
+	version, err := a.deps.Manager.Backup(stream.Context(), w, req.Since)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		a.log.WithError(err).Error("backup failed")
+		return stream.Send(&storepb.BackupChunk{
+			Error: protoError(err),
+		})
+	}
+
+	a.log.WithField("version", version).Info("backup finished")
+	return stream.Send(&storepb.BackupChunk{
+		Version: version,
+	})
+}
+
+func (a *AdminServer) Restore(stream storepb.Admin_RestoreServer) error {
+	err := a.deps.Manager.Restore(stream.Context(), &chunkReader{stream: stream})
+	if err != nil {
+		a.log.WithError(err).Error("restore failed")
+		return stream.SendAndClose(&storepb.RestoreResponse{
+			Error: protoError(err),
+		})
+	}
+
+	a.log.Info("restore finished")
+	return stream.SendAndClose(&storepb.RestoreResponse{})
+}
+
+// chunkWriter sends every write as backup chunks.
+type chunkWriter struct {
+	stream storepb.Admin_BackupServer
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	for n := 0; n < len(p); {
+		size := min(len(p)-n, backupChunkSize)
+		if err := w.stream.Send(&storepb.BackupChunk{Data: p[n : n+size]}); err != nil {
+			return n, err
+		}
+		n += size
+	}
+	return len(p), nil
+}
+
+// chunkReader reads restore chunks until the client closes the stream.
+type chunkReader struct {
+	stream storepb.Admin_RestoreServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (a *AdminServer) Count(ctx context.Context, req *storepb.CountRequest) (*storepb.CountResponse, error) {
+	count, err := a.deps.Manager.Count(ctx, req.Prefix)
+	if err != nil {
+		return &storepb.CountResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.CountResponse{
+		Count: count,
+	}, nil
+}
+
+func (a *AdminServer) Stats(ctx context.Context, _ *storepb.StatsRequest) (*storepb.StatsResponse, error) {
+	stats, err := a.deps.Manager.Stats(ctx)
+	if err != nil {
+		return &storepb.StatsResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.StatsResponse{
+		Keys:         stats.Keys,
+		LogicalBytes: stats.LogicalBytes,
+		DiskBytes:    stats.DiskBytes,
+		LsmBytes:     stats.LSMBytes,
+		VlogBytes:    stats.VlogBytes,
+	}, nil
+}
+
+func (a *AdminServer) Compact(ctx context.Context, _ *storepb.CompactRequest) (*storepb.CompactResponse, error) {
+	reclaimed, err := a.deps.Manager.Compact(ctx)
+	if err != nil {
+		return &storepb.CompactResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.CompactResponse{
+		ReclaimedBytes: reclaimed,
+	}, nil
+}
+
+func (a *AdminServer) RotateKeys(ctx context.Context, req *storepb.RotateKeysRequest) (*storepb.RotateKeysResponse, error) {
+	if req.Reencrypt && !a.reencrypting.CompareAndSwap(false, true) {
+		return &storepb.RotateKeysResponse{
+			Error: protoError(errReencryptRunning),
+		}, nil
+	}
+
+	n, err := a.deps.Manager.RotateKeys(ctx)
+	if err != nil {
+		if req.Reencrypt {
+			a.reencrypting.Store(false)
+		}
+		return &storepb.RotateKeysResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	if req.Reencrypt {
+		go a.reencrypt()
+	}
+	return &storepb.RotateKeysResponse{
+		Namespaces: int64(n),
+	}, nil
+}
+
+// reencrypt outlives the request which started it.
+func (a *AdminServer) reencrypt() {
+	defer a.reencrypting.Store(false)
+
+	count, err := a.deps.Manager.Reencrypt(context.Background())
+	if err != nil {
+		a.log.WithError(err).Error("re-encryption failed")
+		return
+	}
+	a.log.WithField("count", count).Info("re-encryption finished")
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"errors"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+)
+
+var (
+	errConflictingConditions = errors.New("if_absent and if_version are mutually exclusive")
+	errConditionalTTL        = errors.New("ttl is not supported by conditional writes")
+	errNegativeTTL           = errors.New("ttl can not be negative")
+	errTxnNotFound           = errors.New("transaction not found")
+	errSnapshotNotFound      = errors.New("snapshot not found")
+	errReencryptRunning      = errors.New("re-encryption is already running")
+)
+
+func protoError(err error) *storepb.Error {
+	code := storepb.ERROR_UNKNOWN
+
+	switch {
+	case errors.Is(err, manager.ErrNotFound):
+		code = storepb.ERROR_NOT_FOUND
+	case errors.Is(err, manager.ErrConflict):
+		code = storepb.ERROR_CONFLICT
+	case errors.Is(err, manager.ErrUnknownOp),
+		errors.Is(err, errConflictingConditions),
+		errors.Is(err, errConditionalTTL),
+		errors.Is(err, errNegativeTTL),
+		errors.Is(err, manager.ErrUnsupportedBackup),
+		errors.Is(err, manager.ErrInvalidDump),
+		errors.Is(err, manager.ErrInvalidCursor),
+		errors.Is(err, manager.ErrEmptyPrefix),
+		errors.Is(err, manager.ErrCompactUnsupported),
+		errors.Is(err, manager.ErrEncryptionDisabled),
+		errors.Is(err, errReencryptRunning):
+		code = storepb.ERROR_INVALID_ARGUMENT
+	case errors.Is(err, errTxnNotFound):
+		code = storepb.ERROR_TXN_NOT_FOUND
+	case errors.Is(err, errSnapshotNotFound):
+		code = storepb.ERROR_SNAPSHOT_NOT_FOUND
+	case errors.Is(err, manager.ErrWatchLagged):
+		code = storepb.ERROR_WATCH_LAGGED
+	}
+
+	return &storepb.Error{
+		Message: err.Error(),
+		Code:    code,
+	}
+}
@@ -2,26 +2,66 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"kvstore/internal/protobuf/storepb"
 	"kvstore/internal/storeservice/manager"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
 
-func Register(deps Dependencies) {
-	storepb.RegisterStoreServer(deps.Server, &Server{
+const (
+	defaultTxnTimeout      = 30 * time.Second
+	defaultSnapshotTimeout = time.Minute
+)
+
+func Register(cfg Config, deps Dependencies) {
+	if cfg.TxnTimeout <= 0 {
+		cfg.TxnTimeout = defaultTxnTimeout
+	}
+	if cfg.SnapshotTimeout <= 0 {
+		cfg.SnapshotTimeout = defaultSnapshotTimeout
+	}
+
+	srv := &Server{
+		cfg:  cfg,
+		deps: deps,
+		log:  deps.Log.WithField("component", "server"),
+	}
+	srv.txns = newSessions(cfg.TxnTimeout, srv.expireTxn)
+	srv.snapshots = newSessions(cfg.SnapshotTimeout, srv.expireSnapshot)
+
+	storepb.RegisterStoreServer(deps.Server, srv)
+	storepb.RegisterAdminServer(deps.Server, &AdminServer{
 		deps: deps,
+		log:  deps.Log.WithField("component", "admin"),
 	})
 }
 
+type Config struct {
+	// TxnTimeout is how long a transaction may stay idle before it is
+	// rolled back.
+	TxnTimeout time.Duration
+	// SnapshotTimeout is how long a snapshot may stay idle before it is
+	// released.
+	SnapshotTimeout time.Duration
+}
+
 type Dependencies struct {
 	Server  *grpc.Server
 	Manager manager.Manager
+	Log     *logrus.Logger
 }
 
 type Server struct {
+	cfg  Config
 	deps Dependencies
 
+	txns      *sessions[manager.Txn]
+	snapshots *sessions[manager.Snapshot]
+	log       *logrus.Entry
+
 	storepb.UnimplementedStoreServer
 }
 
@@ -29,24 +69,40 @@ func (s *Server) Get(ctx context.Context, req *storepb.GetRequest) (*storepb.Get
 	result, err := s.deps.Manager.Get(ctx, []byte(req.Key))
 	if err != nil {
 		return &storepb.GetResponse{
-			Error: &storepb.Error{
-				Message: err.Error(),
-			},
+			Error: protoError(err),
 		}, nil
 	}
 
 	return &storepb.GetResponse{
-		Value: []byte(result.Value),
+		Value:   []byte(result.Value),
+		Version: result.Version,
 	}, nil
 }
 
 func (s *Server) Put(ctx context.Context, req *storepb.PutRequest) (*storepb.PutResponse, error) {
-	err := s.deps.Manager.Set(ctx, []byte(req.Key), []byte(req.Value))
+	var (
+		key = []byte(req.Key)
+		ttl = time.Duration(req.TtlMs) * time.Millisecond
+		err error
+	)
+
+	switch {
+	case ttl < 0:
+		err = errNegativeTTL
+	case req.IfAbsent && req.IfVersion != 0:
+		err = errConflictingConditions
+	case (req.IfAbsent || req.IfVersion != 0) && ttl != 0:
+		err = errConditionalTTL
+	case req.IfAbsent:
+		err = s.deps.Manager.SetIfAbsent(ctx, key, req.Value)
+	case req.IfVersion != 0:
+		err = s.deps.Manager.SetIfVersion(ctx, key, req.Value, req.IfVersion)
+	default:
+		err = s.deps.Manager.SetWithTTL(ctx, key, req.Value, ttl)
+	}
 	if err != nil {
 		return &storepb.PutResponse{
-			Error: &storepb.Error{
-				Message: err.Error(),
-			},
+			Error: protoError(err),
 		}, nil
 	}
 
@@ -54,3 +110,128 @@ func (s *Server) Put(ctx context.Context, req *storepb.PutRequest) (*storepb.Put
 		Error: nil,
 	}, nil
 }
+
+func (s *Server) Delete(ctx context.Context, req *storepb.DeleteRequest) (*storepb.DeleteResponse, error) {
+	var (
+		key = []byte(req.Key)
+		err error
+	)
+
+	if req.IfVersion != 0 {
+		err = s.deps.Manager.DeleteIfVersion(ctx, key, req.IfVersion)
+	} else {
+		err = s.deps.Manager.Delete(ctx, key)
+	}
+	if err != nil {
+		return &storepb.DeleteResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.DeleteResponse{
+		Error: nil,
+	}, nil
+}
+
+func (s *Server) DeletePrefix(ctx context.Context, req *storepb.DeletePrefixRequest) (*storepb.BulkDeleteResponse, error) {
+	count, err := s.deps.Manager.DeletePrefix(ctx, req.Prefix, manager.DeleteOptions{
+		DryRun: req.DryRun,
+		All:    req.All,
+	})
+	return bulkDeleteResponse(count, err), nil
+}
+
+func (s *Server) DeleteRange(ctx context.Context, req *storepb.DeleteRangeRequest) (*storepb.BulkDeleteResponse, error) {
+	count, err := s.deps.Manager.DeleteRange(ctx, req.Start, req.End, manager.DeleteOptions{
+		DryRun: req.DryRun,
+		All:    req.All,
+	})
+	return bulkDeleteResponse(count, err), nil
+}
+
+func bulkDeleteResponse(count int64, err error) *storepb.BulkDeleteResponse {
+	if err != nil {
+		return &storepb.BulkDeleteResponse{
+			Error: protoError(err),
+		}
+	}
+
+	return &storepb.BulkDeleteResponse{
+		Count: count,
+	}
+}
+
+func (s *Server) Scan(ctx context.Context, req *storepb.ScanRequest) (*storepb.ScanResponse, error) {
+	result, err := s.deps.Manager.Scan(ctx, scanOptionsFromProto(req))
+	if err != nil {
+		return &storepb.ScanResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return scanResponse(result), nil
+}
+
+func scanOptionsFromProto(req *storepb.ScanRequest) manager.ScanOptions {
+	return manager.ScanOptions{
+		Limit:   int(req.GetLimit()),
+		Prefix:  req.GetPrefix(),
+		Start:   req.GetStart(),
+		End:     req.GetEnd(),
+		Reverse: req.GetReverse(),
+		Cursor:  req.GetCursor(),
+	}
+}
+
+func scanResponse(result manager.ScanResult) *storepb.ScanResponse {
+	items := make([]*storepb.KeyValue, 0, len(result.List))
+	for _, kv := range result.List {
+		items = append(items, &storepb.KeyValue{
+			Key:   kv.Key,
+			Value: []byte(kv.Value),
+		})
+	}
+
+	return &storepb.ScanResponse{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	}
+}
+
+func (s *Server) Batch(ctx context.Context, req *storepb.BatchRequest) (*storepb.BatchResponse, error) {
+	ops := make([]manager.Op, 0, len(req.Ops))
+	for _, op := range req.Ops {
+		if op.TtlMs < 0 {
+			return &storepb.BatchResponse{
+				Error: protoError(fmt.Errorf("%w: %s", errNegativeTTL, op.Key)),
+			}, nil
+		}
+		o := manager.Op{
+			Key:   []byte(op.Key),
+			Value: op.Value,
+			TTL:   time.Duration(op.TtlMs) * time.Millisecond,
+		}
+		switch op.Type {
+		case storepb.OP_SET:
+			o.Type = manager.OpSet
+		case storepb.OP_DELETE:
+			o.Type = manager.OpDelete
+		default:
+			return &storepb.BatchResponse{
+				Error: protoError(fmt.Errorf("%w: %v", manager.ErrUnknownOp, op.Type)),
+			}, nil
+		}
+		ops = append(ops, o)
+	}
+
+	err := s.deps.Manager.Write(ctx, ops)
+	if err != nil {
+		return &storepb.BatchResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.BatchResponse{
+		Error: nil,
+	}, nil
+}
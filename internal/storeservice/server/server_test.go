@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+	"kvstore/internal/storeservice/store/mapkv"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegativeTTL(t *testing.T) {
+	ctx := context.Background()
+	store := mapkv.NewStore()
+	defer store.Close()
+	srv := &Server{deps: Dependencies{
+		Manager: manager.New(manager.Config{}, manager.Dependencies{Store: store, Log: logrus.StandardLogger()}),
+	}}
+
+	put, err := srv.Put(ctx, &storepb.PutRequest{Key: "a", Value: []byte("1"), TtlMs: -1})
+	require.NoError(t, err)
+	require.NotNil(t, put.Error)
+	require.Equal(t, storepb.ERROR_INVALID_ARGUMENT, put.Error.Code)
+
+	batch, err := srv.Batch(ctx, &storepb.BatchRequest{Ops: []*storepb.Op{
+		{Type: storepb.OP_SET, Key: "b", Value: []byte("1")},
+		{Type: storepb.OP_SET, Key: "c", Value: []byte("1"), TtlMs: -1},
+	}})
+	require.NoError(t, err)
+	require.NotNil(t, batch.Error)
+	require.Equal(t, storepb.ERROR_INVALID_ARGUMENT, batch.Error.Code)
+
+	for _, key := range []string{"a", "b", "c"} {
+		get, err := srv.Get(ctx, &storepb.GetRequest{Key: key})
+		require.NoError(t, err)
+		require.NotNil(t, get.Error, key)
+		require.Equal(t, storepb.ERROR_NOT_FOUND, get.Error.Code, key)
+	}
+}
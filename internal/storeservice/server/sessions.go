@@ -0,0 +1,119 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// sessions keeps server-side state that spans several requests, such as
+// interactive transactions. Sessions idle for longer than the timeout are
+// passed to expire and removed.
+type sessions[T any] struct {
+	timeout time.Duration
+	expire  func(T)
+
+	mu    sync.Mutex
+	items map[string]*session[T]
+}
+
+type session[T any] struct {
+	id    string
+	value T
+
+	// mu gives exclusive access to the value between acquire and
+	// release or remove.
+	mu       sync.Mutex
+	timer    *time.Timer
+	deadline time.Time
+	removed  bool
+}
+
+func newSessions[T any](timeout time.Duration, expire func(T)) *sessions[T] {
+	return &sessions[T]{
+		timeout: timeout,
+		expire:  expire,
+		items:   make(map[string]*session[T]),
+	}
+}
+
+func (s *sessions[T]) add(value T) (string, error) {
+	id, err := newSessionID()
+	if err != nil {
+		return "", err
+	}
+
+	sess := &session[T]{
+		id:       id,
+		value:    value,
+		deadline: time.Now().Add(s.timeout),
+	}
+	sess.timer = time.AfterFunc(s.timeout, func() {
+		s.expireSession(id)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items[id] = sess
+	return id, nil
+}
+
+// acquire returns the session locked for exclusive use. The caller must
+// call either release or remove afterwards.
+func (s *sessions[T]) acquire(id string) (*session[T], bool) {
+	s.mu.Lock()
+	sess, ok := s.items[id]
+	s.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	sess.mu.Lock()
+	if sess.removed {
+		sess.mu.Unlock()
+		return nil, false
+	}
+	sess.timer.Stop()
+	return sess, true
+}
+
+func (s *sessions[T]) release(sess *session[T]) {
+	sess.deadline = time.Now().Add(s.timeout)
+	sess.timer.Reset(s.timeout)
+	sess.mu.Unlock()
+}
+
+func (s *sessions[T]) remove(sess *session[T]) {
+	s.mu.Lock()
+	delete(s.items, sess.id)
+	s.mu.Unlock()
+
+	sess.removed = true
+	sess.timer.Stop()
+	sess.mu.Unlock()
+}
+
+func (s *sessions[T]) expireSession(id string) {
+	sess, ok := s.acquire(id)
+	if !ok {
+		return
+	}
+
+	// The session could have been used while the timer was firing.
+	if time.Now().Before(sess.deadline) {
+		s.release(sess)
+		return
+	}
+
+	s.expire(sess.value)
+	s.remove(sess)
+}
+
+func newSessionID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionsExpire(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+	expired := make(chan string, 1)
+	s := newSessions(timeout, func(v string) {
+		expired <- v
+	})
+
+	id, err := s.add("value")
+	require.NoError(t, err)
+
+	// Using the session keeps it alive.
+	for i := 0; i < 3; i++ {
+		time.Sleep(timeout / 2)
+		sess, ok := s.acquire(id)
+		require.True(t, ok)
+		require.Equal(t, "value", sess.value)
+		s.release(sess)
+	}
+
+	select {
+	case v := <-expired:
+		require.Equal(t, "value", v)
+	case <-time.After(10 * timeout):
+		t.Fatal("session was not expired")
+	}
+
+	_, ok := s.acquire(id)
+	require.False(t, ok)
+}
+
+func TestSessionsRemove(t *testing.T) {
+	s := newSessions(time.Hour, func(string) {
+		t.Fatal("removed session must not expire")
+	})
+
+	id, err := s.add("value")
+	require.NoError(t, err)
+
+	sess, ok := s.acquire(id)
+	require.True(t, ok)
+	s.remove(sess)
+
+	_, ok = s.acquire(id)
+	require.False(t, ok)
+}
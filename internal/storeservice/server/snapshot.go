@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+)
+
+func (s *Server) CreateSnapshot(ctx context.Context, _ *storepb.CreateSnapshotRequest) (*storepb.CreateSnapshotResponse, error) {
+	snap, err := s.deps.Manager.Snapshot(ctx)
+	if err != nil {
+		return &storepb.CreateSnapshotResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	id, err := s.snapshots.add(snap)
+	if err != nil {
+		_ = snap.Close()
+		return &storepb.CreateSnapshotResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.CreateSnapshotResponse{
+		SnapshotId: id,
+	}, nil
+}
+
+func (s *Server) SnapshotGet(ctx context.Context, req *storepb.SnapshotGetRequest) (*storepb.SnapshotGetResponse, error) {
+	var result manager.GetResult
+	err := s.withSnapshot(req.SnapshotId, func(snap manager.Snapshot) error {
+		var err error
+		result, err = snap.Get(ctx, []byte(req.Key))
+		return err
+	})
+	if err != nil {
+		return &storepb.SnapshotGetResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.SnapshotGetResponse{
+		Value: []byte(result.Value),
+	}, nil
+}
+
+func (s *Server) SnapshotScan(ctx context.Context, req *storepb.SnapshotScanRequest) (*storepb.ScanResponse, error) {
+	var result manager.ScanResult
+	err := s.withSnapshot(req.SnapshotId, func(snap manager.Snapshot) error {
+		var err error
+		result, err = snap.Scan(ctx, scanOptionsFromProto(req.Scan))
+		return err
+	})
+	if err != nil {
+		return &storepb.ScanResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return scanResponse(result), nil
+}
+
+func (s *Server) ReleaseSnapshot(_ context.Context, req *storepb.ReleaseSnapshotRequest) (*storepb.ReleaseSnapshotResponse, error) {
+	sess, ok := s.snapshots.acquire(req.SnapshotId)
+	if !ok {
+		return &storepb.ReleaseSnapshotResponse{
+			Error: protoError(errSnapshotNotFound),
+		}, nil
+	}
+	defer s.snapshots.remove(sess)
+
+	if err := sess.value.Close(); err != nil {
+		return &storepb.ReleaseSnapshotResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.ReleaseSnapshotResponse{
+		Error: nil,
+	}, nil
+}
+
+func (s *Server) withSnapshot(id string, f func(manager.Snapshot) error) error {
+	sess, ok := s.snapshots.acquire(id)
+	if !ok {
+		return errSnapshotNotFound
+	}
+	defer s.snapshots.release(sess)
+
+	return f(sess.value)
+}
+
+func (s *Server) expireSnapshot(snap manager.Snapshot) {
+	s.log.Warn("releasing abandoned snapshot")
+	_ = snap.Close()
+}
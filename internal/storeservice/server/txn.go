@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+)
+
+func (s *Server) BeginTxn(ctx context.Context, _ *storepb.BeginTxnRequest) (*storepb.BeginTxnResponse, error) {
+	txn, err := s.deps.Manager.Begin(ctx)
+	if err != nil {
+		return &storepb.BeginTxnResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	id, err := s.txns.add(txn)
+	if err != nil {
+		_ = txn.Rollback()
+		return &storepb.BeginTxnResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.BeginTxnResponse{
+		TxnId: id,
+	}, nil
+}
+
+func (s *Server) TxnGet(ctx context.Context, req *storepb.TxnGetRequest) (*storepb.TxnGetResponse, error) {
+	var result manager.GetResult
+	err := s.withTxn(req.TxnId, func(txn manager.Txn) error {
+		var err error
+		result, err = txn.Get(ctx, []byte(req.Key))
+		return err
+	})
+	if err != nil {
+		return &storepb.TxnGetResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.TxnGetResponse{
+		Value: []byte(result.Value),
+	}, nil
+}
+
+func (s *Server) TxnPut(ctx context.Context, req *storepb.TxnPutRequest) (*storepb.TxnPutResponse, error) {
+	err := s.withTxn(req.TxnId, func(txn manager.Txn) error {
+		return txn.Set(ctx, []byte(req.Key), req.Value)
+	})
+	if err != nil {
+		return &storepb.TxnPutResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.TxnPutResponse{
+		Error: nil,
+	}, nil
+}
+
+func (s *Server) TxnDelete(ctx context.Context, req *storepb.TxnDeleteRequest) (*storepb.TxnDeleteResponse, error) {
+	err := s.withTxn(req.TxnId, func(txn manager.Txn) error {
+		return txn.Delete(ctx, []byte(req.Key))
+	})
+	if err != nil {
+		return &storepb.TxnDeleteResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.TxnDeleteResponse{
+		Error: nil,
+	}, nil
+}
+
+func (s *Server) CommitTxn(ctx context.Context, req *storepb.CommitTxnRequest) (*storepb.CommitTxnResponse, error) {
+	err := s.finishTxn(req.TxnId, func(txn manager.Txn) error {
+		return txn.Commit(ctx)
+	})
+	if err != nil {
+		return &storepb.CommitTxnResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.CommitTxnResponse{
+		Error: nil,
+	}, nil
+}
+
+func (s *Server) RollbackTxn(_ context.Context, req *storepb.RollbackTxnRequest) (*storepb.RollbackTxnResponse, error) {
+	err := s.finishTxn(req.TxnId, func(txn manager.Txn) error {
+		return txn.Rollback()
+	})
+	if err != nil {
+		return &storepb.RollbackTxnResponse{
+			Error: protoError(err),
+		}, nil
+	}
+
+	return &storepb.RollbackTxnResponse{
+		Error: nil,
+	}, nil
+}
+
+func (s *Server) withTxn(id string, f func(manager.Txn) error) error {
+	sess, ok := s.txns.acquire(id)
+	if !ok {
+		return errTxnNotFound
+	}
+	defer s.txns.release(sess)
+
+	return f(sess.value)
+}
+
+// finishTxn runs f and forgets the transaction regardless of the result.
+func (s *Server) finishTxn(id string, f func(manager.Txn) error) error {
+	sess, ok := s.txns.acquire(id)
+	if !ok {
+		return errTxnNotFound
+	}
+	defer s.txns.remove(sess)
+
+	return f(sess.value)
+}
+
+func (s *Server) expireTxn(txn manager.Txn) {
+	s.log.Warn("rolling back abandoned transaction")
+	_ = txn.Rollback()
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/protobuf/storepb"
+	"kvstore/internal/storeservice/manager"
+)
+
+func (s *Server) Watch(req *storepb.WatchRequest, stream storepb.Store_WatchServer) error {
+	err := s.deps.Manager.Watch(stream.Context(), req.Prefix, req.FromVersion, func(e manager.Event) error {
+		event := &storepb.WatchEvent{
+			Type:    storepb.EVENT_PUT,
+			Key:     e.Key,
+			Value:   []byte(e.Value),
+			Version: e.Version,
+		}
+		if e.Type == manager.EventDelete {
+			event.Type = storepb.EVENT_DELETE
+			event.Value = nil
+		}
+		return stream.Send(event)
+	})
+
+	// The client is gone when its context is done.
+	if err == nil || errors.Is(err, context.Canceled) || stream.Context().Err() != nil {
+		return nil
+	}
+	s.log.WithError(err).WithField("prefix", req.Prefix).Warn("watch failed")
+	return stream.Send(&storepb.WatchEvent{
+		Error: protoError(err),
+	})
+}
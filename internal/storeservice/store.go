@@ -3,9 +3,16 @@ package storeservice
 import (
 	"context"
 	"kvstore/internal/common/grpcserver"
+	"kvstore/internal/common/metricsserver"
 	"kvstore/internal/storeservice/manager"
 	"kvstore/internal/storeservice/server"
-	"kvstore/internal/storeservice/store/badgerkv"
+	"kvstore/internal/storeservice/store"
+	_ "kvstore/internal/storeservice/store/badgerkv"
+	_ "kvstore/internal/storeservice/store/bitcask"
+	"kvstore/internal/storeservice/store/cachekv"
+	"kvstore/internal/storeservice/store/kv"
+	_ "kvstore/internal/storeservice/store/mapkv"
+	"kvstore/internal/storeservice/store/metricskv"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -13,8 +20,13 @@ import (
 
 type Config struct {
 	Server  grpcserver.Config
+	API     server.Config
 	Manager manager.Config
-	Store   badgerkv.Config
+	Store   store.Config
+	// Metrics serves /metrics over HTTP, empty Address disables it.
+	Metrics metricsserver.Config
+	// Cache wraps the store with a read cache when it is enabled.
+	Cache cachekv.Config
 }
 
 type Dependencies struct {
@@ -35,25 +47,88 @@ func New(cfg Config, deps Dependencies) *StoreService {
 }
 
 func (ss *StoreService) Run(ctx context.Context) error {
-	store, err := badgerkv.New(ss.cfg.Store, badgerkv.Dependencies{
-		Log: ss.deps.Log,
-	})
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	kvs, err := ss.openStore()
 	if err != nil {
 		return err
 	}
+	defer kvs.Close()
 
 	mgr := manager.New(ss.cfg.Manager, manager.Dependencies{
-		Store: store,
+		Store: kvs,
 		Log:   ss.deps.Log,
 	})
+	if ss.deps.Registry != nil {
+		if mgr, err = manager.Instrument(mgr, ss.deps.Registry); err != nil {
+			return err
+		}
+	}
 
 	srv := grpcserver.NewGRPCServer(ss.cfg.Server, grpcserver.Dependencies{
 		Log: ss.deps.Log,
 	})
-	server.Register(server.Dependencies{
+	server.Register(ss.cfg.API, server.Dependencies{
 		Server:  srv.Server,
 		Manager: mgr,
+		Log:     ss.deps.Log,
 	})
 
-	return srv.Run(ctx)
+	// The service stops when either server fails.
+	metricsDone := make(chan error, 1)
+	if ss.cfg.Metrics.Address == "" || ss.deps.Registry == nil {
+		metricsDone <- nil
+	} else {
+		ms := metricsserver.New(ss.cfg.Metrics, metricsserver.Dependencies{
+			Registry: ss.deps.Registry,
+			Log:      ss.deps.Log,
+		})
+		go func() {
+			err := ms.Run(ctx)
+			cancel()
+			metricsDone <- err
+		}()
+	}
+
+	err = srv.Run(ctx)
+	cancel()
+	if merr := <-metricsDone; err == nil {
+		err = merr
+	}
+	return err
+}
+
+// openStore opens the backend wrapped with the cache and the metrics.
+func (ss *StoreService) openStore() (kv.Store, error) {
+	kvs, err := store.New(ss.cfg.Store, store.Dependencies{
+		Log:      ss.deps.Log,
+		Registry: ss.deps.Registry,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ss.cfg.Cache.Enabled() {
+		cached, err := cachekv.New(ss.cfg.Cache, cachekv.Dependencies{
+			Store:    kvs,
+			Registry: ss.deps.Registry,
+		})
+		if err != nil {
+			kvs.Close()
+			return nil, err
+		}
+		kvs = cached
+	}
+	if ss.deps.Registry != nil {
+		instrumented, err := metricskv.New(metricskv.Dependencies{
+			Store:    kvs,
+			Registry: ss.deps.Registry,
+		})
+		if err != nil {
+			kvs.Close()
+			return nil, err
+		}
+		kvs = instrumented
+	}
+	return kvs, nil
 }
@@ -0,0 +1,174 @@
+package badgerkv
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvdump"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/badger/v4/pb"
+)
+
+const (
+	// bitDelete marks deleted keys in badger's native backups.
+	bitDelete byte = 1 << 0
+	// maxListSize guards against allocating huge buffers when the input
+	// is not a backup at all.
+	maxListSize = 1 << 30
+)
+
+// Backup uses badger's native backup format, incremental backups carry
+// deletions as well. Badger streams the backup without a context, it is
+// stopped by failing the writes once ctx is done.
+func (b *badgerkv) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	version, err := b.db.Backup(ctxWriter{ctx: ctx, w: w}, since)
+	if err != nil {
+		return 0, err
+	}
+	if version < since {
+		// Nothing was written since the previous backup.
+		version = since
+	}
+	return version, nil
+}
+
+// ctxWriter fails the writes once ctx is done.
+type ctxWriter struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+func (w ctxWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return w.w.Write(p)
+}
+
+// Restore accepts both badger's native backups and kvdump dumps. In both
+// cases the entries are written with new versions, so a backup always
+// overwrites the current data of its keys.
+func (b *badgerkv) Restore(ctx context.Context, r io.Reader) error {
+	wb := b.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	var (
+		br  = bufio.NewReader(r)
+		err error
+	)
+	if kvdump.IsDump(br) {
+		err = restoreDump(ctx, wb, br)
+	} else {
+		err = restoreNative(ctx, wb, br)
+	}
+	if err != nil {
+		return err
+	}
+
+	return wb.Flush()
+}
+
+// restoreDump writes the entries of the dump, entries which have already
+// expired are skipped.
+func restoreDump(ctx context.Context, wb *badger.WriteBatch, r io.Reader) error {
+	dr, err := kvdump.NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		e, err := dr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		entry := newEntry(e.Key, e.Value, 0)
+		if !e.ExpiresAt.IsZero() {
+			if !now.Before(e.ExpiresAt) {
+				continue
+			}
+			entry.ExpiresAt = uint64(e.ExpiresAt.Unix())
+		}
+		if err := wb.SetEntry(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// restoreNative replays a backup produced by badger's DB.Backup. The
+// backup is a sequence of size prefixed KV lists, every key lists its
+// versions from the newest, only the newest one is applied.
+func restoreNative(ctx context.Context, wb *badger.WriteBatch, r io.Reader) error {
+	var (
+		buf     []byte
+		lastKey []byte
+		now     = uint64(time.Now().Unix())
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var size uint64
+		err := binary.Read(r, binary.LittleEndian, &size)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if size > maxListSize {
+			return fmt.Errorf("%w: list of %d bytes", kv.ErrUnsupportedBackup, size)
+		}
+		if uint64(cap(buf)) < size {
+			buf = make([]byte, size)
+		}
+		buf = buf[:size]
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return err
+		}
+
+		var list pb.KVList
+		if err := list.Unmarshal(buf); err != nil {
+			return fmt.Errorf("%w: %v", kv.ErrUnsupportedBackup, err)
+		}
+
+		for _, item := range list.Kv {
+			if lastKey != nil && string(item.Key) == string(lastKey) {
+				continue
+			}
+			lastKey = item.Key
+
+			deleted := len(item.Meta) > 0 && item.Meta[0]&bitDelete != 0
+			if deleted || (item.ExpiresAt != 0 && item.ExpiresAt <= now) {
+				err = wb.Delete(item.Key)
+			} else {
+				entry := newEntry(item.Key, item.Value, 0)
+				entry.ExpiresAt = item.ExpiresAt
+				err = wb.SetEntry(entry)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,52 +1,173 @@
 package badgerkv
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/watch"
+	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger/v4"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 )
 
 type Config struct {
+	// InMem keeps all data in memory, Root is ignored then.
 	InMem bool
-	Root  string
+	// Root is the directory of the database.
+	Root string
+	// SyncWrites syncs every write to disk before acknowledging it.
+	SyncWrites bool
+	// ValueLogFileSize is the maximum size of a value log file in bytes.
+	ValueLogFileSize int64
+	// BlockCacheSize and IndexCacheSize are the cache sizes in bytes.
+	BlockCacheSize int64
+	IndexCacheSize int64
+	// ValueThreshold is the size from which values are kept in the value
+	// log rather than in the LSM tree.
+	ValueThreshold int64
+	// EncryptionKey encrypts the whole database with AES-128, AES-192 or
+	// AES-256 for 16, 24 or 32 bytes, empty disables the encryption. A
+	// database must always be opened with the key it was created with.
+	EncryptionKey []byte
+	// EncryptionKeyRotation is how often badger replaces the data keys
+	// it encrypts with EncryptionKey, zero keeps the badger default.
+	EncryptionKeyRotation time.Duration
+
+	// GCInterval is how often the value log is garbage collected, zero
+	// disables the collection.
+	GCInterval time.Duration
+	// GCDiscardRatio is the part of a value log file which must be
+	// garbage for the file to be rewritten.
+	GCDiscardRatio float64
+	// GCQuietHours limits the collection to a daily window.
+	GCQuietHours QuietHours
 }
 
+// encryptedIndexCacheSize is the index cache size of an encrypted
+// database which does not set one.
+const encryptedIndexCacheSize = 64 << 20
+
 type Dependencies struct {
 	Log *logrus.Logger
+	// Registry gets the garbage collection metrics, nil leaves them out.
+	Registry *prometheus.Registry
 }
 
 type badgerkv struct {
 	cfg  Config
 	deps Dependencies
 
-	db *badger.DB
+	db  *badger.DB
+	hub *watch.Hub
+	// stopWatch ends the subscription feeding hub, watchDone is closed
+	// when it ended.
+	stopWatch context.CancelFunc
+	watchDone chan struct{}
+
+	// gcMu serializes garbage collections and compactions, gcClosed is
+	// set under it once the store is closing.
+	gcMu     sync.Mutex
+	gcClosed bool
+	metrics  *gcMetrics
+	stopGC   chan struct{}
+	gcDone   chan struct{}
 }
 
 func New(cfg Config, deps Dependencies) (kv.Store, error) {
+	if cfg.GCInterval > 0 && (cfg.GCDiscardRatio <= 0 || cfg.GCDiscardRatio >= 1) {
+		return nil, fmt.Errorf("gc discard ratio %v is not between 0 and 1", cfg.GCDiscardRatio)
+	}
+
 	ret := &badgerkv{
-		cfg:  cfg,
-		deps: deps,
+		cfg:     cfg,
+		deps:    deps,
+		metrics: newGCMetrics(),
 	}
 
-	opts := badger.DefaultOptions(cfg.Root)
-	opts.WithInMemory(cfg.InMem)
-	opts.Logger = deps.Log
+	opts := badger.DefaultOptions(cfg.Root).
+		WithSyncWrites(cfg.SyncWrites).
+		WithLogger(deps.Log)
+	if cfg.InMem {
+		opts = opts.WithInMemory(true).WithDir("").WithValueDir("")
+	}
+	// Zero sizes keep badger's defaults.
+	if cfg.ValueLogFileSize > 0 {
+		opts = opts.WithValueLogFileSize(cfg.ValueLogFileSize)
+	}
+	if cfg.BlockCacheSize > 0 {
+		opts = opts.WithBlockCacheSize(cfg.BlockCacheSize)
+	}
+	if cfg.IndexCacheSize > 0 {
+		opts = opts.WithIndexCacheSize(cfg.IndexCacheSize)
+	}
+	if cfg.ValueThreshold > 0 {
+		opts = opts.WithValueThreshold(cfg.ValueThreshold)
+	}
+	if len(cfg.EncryptionKey) > 0 {
+		opts = opts.WithEncryptionKey(cfg.EncryptionKey)
+		// Badger panics reading encrypted tables without an index
+		// cache.
+		if cfg.IndexCacheSize <= 0 {
+			opts = opts.WithIndexCacheSize(encryptedIndexCacheSize)
+		}
+	}
+	if cfg.EncryptionKeyRotation > 0 {
+		opts = opts.WithEncryptionKeyRotationDuration(cfg.EncryptionKeyRotation)
+	}
 
 	db, err := badger.Open(opts)
 	if err != nil {
-		return nil, err
+		if cfg.InMem {
+			return nil, fmt.Errorf("open in-memory badger: %w", err)
+		}
+		return nil, fmt.Errorf("open badger at %s: %w", cfg.Root, err)
+	}
+
+	if deps.Registry != nil {
+		if err := deps.Registry.Register(ret.metrics); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("register badger metrics: %w", err)
+		}
 	}
 
 	ret.db = db
+	ret.startWatch()
+	ret.startGC()
 	return ret, nil
 }
 
-func (b *badgerkv) Set(_ context.Context, k kv.Key, v kv.Value) error {
+// userMetaValue marks the entries which hold a value. Subscribe does not
+// report badger's delete bit, so entries without it are deletes.
+const userMetaValue byte = 1
+
+func newEntry(k kv.Key, v kv.Value, ttl time.Duration) *badger.Entry {
+	e := badger.NewEntry(k, v).WithMeta(userMetaValue)
+	if ttl > 0 {
+		e = e.WithTTL(ttl)
+	}
+	return e
+}
+
+func (b *badgerkv) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	return b.SetWithTTL(ctx, k, v, 0)
+}
+
+// SetWithTTL relies on badger's native expiration which has a
+// granularity of one second.
+func (b *badgerkv) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e := newEntry(k, v, ttl)
+
 	err := b.db.Update(func(txn *badger.Txn) error {
-		err := txn.Set(k, v)
+		err := txn.SetEntry(e)
 		return err
 	})
 	if err != nil {
@@ -55,29 +176,123 @@ func (b *badgerkv) Set(_ context.Context, k kv.Key, v kv.Value) error {
 	return nil
 }
 
-func (b *badgerkv) Get(_ context.Context, k kv.Key) (kv.Value, error) {
-	var valCopy []byte
+func (b *badgerkv) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	v, _, err := b.GetWithVersion(ctx, k)
+	return v, err
+}
+
+func (b *badgerkv) GetWithVersion(ctx context.Context, k kv.Key) (kv.Value, uint64, error) {
+	v, version, _, err := b.GetWithExpiry(ctx, k)
+	return v, version, err
+}
+
+// GetWithExpiry has the precision of badger's expiry, whole seconds.
+func (b *badgerkv) GetWithExpiry(ctx context.Context, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	var (
+		valCopy   []byte
+		version   uint64
+		expiresAt time.Time
+	)
 
 	err := b.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(k)
-		if err != nil {
-			return err
-		}
-		valCopy, err = item.ValueCopy(nil)
+		var err error
+		valCopy, version, expiresAt, err = getItem(txn, k)
 		return err
 	})
 
-	if err != nil && !errors.Is(err, badger.ErrKeyNotFound) {
+	if err != nil && !errors.Is(err, kv.ErrNotFound) {
 		b.deps.Log.Errorf("failed to get key=%s: %v", k, err)
-		return nil, err
-	} else if errors.Is(err, badger.ErrKeyNotFound) {
-		return nil, kv.ErrNotFound
+		return nil, 0, time.Time{}, err
+	} else if errors.Is(err, kv.ErrNotFound) {
+		return nil, 0, time.Time{}, kv.ErrNotFound
+	}
+
+	return valCopy, version, expiresAt, nil
+}
+
+// getItem reads a copy of the value, the version and the expiry of the
+// key.
+func getItem(txn *badger.Txn, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	item, err := txn.Get(k)
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, 0, time.Time{}, kv.ErrNotFound
+	} else if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	val, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	var expiresAt time.Time
+	if item.ExpiresAt() != 0 {
+		expiresAt = time.Unix(int64(item.ExpiresAt()), 0)
 	}
+	return val, item.Version(), expiresAt, nil
+}
+
+// SetIfVersion uses the commit timestamp of the key as its version.
+func (b *badgerkv) SetIfVersion(ctx context.Context, k kv.Key, v kv.Value, version uint64) error {
+	return b.updateIf(ctx, k, func(item *badger.Item) bool {
+		return item != nil && item.Version() == version
+	}, func(txn *badger.Txn) error {
+		return txn.SetEntry(newEntry(k, v, 0))
+	})
+}
 
-	return valCopy, nil
+func (b *badgerkv) SetIfAbsent(ctx context.Context, k kv.Key, v kv.Value) error {
+	return b.updateIf(ctx, k, func(item *badger.Item) bool {
+		return item == nil
+	}, func(txn *badger.Txn) error {
+		return txn.SetEntry(newEntry(k, v, 0))
+	})
+}
+
+func (b *badgerkv) DeleteIfVersion(ctx context.Context, k kv.Key, version uint64) error {
+	return b.updateIf(ctx, k, func(item *badger.Item) bool {
+		return item != nil && item.Version() == version
+	}, func(txn *badger.Txn) error {
+		return txn.Delete(k)
+	})
 }
 
-func (b *badgerkv) Delete(_ context.Context, k kv.Key) error {
+// updateIf applies the update only if cond holds for the current item of
+// the key, which is nil when the key does not exist. The read is tracked
+// by the transaction, so a concurrent write of the key fails the commit.
+func (b *badgerkv) updateIf(ctx context.Context, k kv.Key, cond func(*badger.Item) bool, update func(*badger.Txn) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := b.db.Update(func(txn *badger.Txn) error {
+		item, err := txn.Get(k)
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			item = nil
+		} else if err != nil {
+			return err
+		}
+
+		if !cond(item) {
+			return kv.ErrConflict
+		}
+		return update(txn)
+	})
+	if errors.Is(err, badger.ErrConflict) {
+		return kv.ErrConflict
+	}
+	return err
+}
+
+func (b *badgerkv) Delete(ctx context.Context, k kv.Key) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	err := b.db.Update(func(txn *badger.Txn) error {
 		err := txn.Delete(k)
 		return err
@@ -88,39 +303,116 @@ func (b *badgerkv) Delete(_ context.Context, k kv.Key) error {
 	return nil
 }
 
+func (b *badgerkv) Write(ctx context.Context, ops []kv.Op) error {
+	if err := kv.ValidateOps(ops); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return b.db.Update(func(txn *badger.Txn) error {
+		for _, op := range ops {
+			var err error
+			switch op.Type {
+			case kv.OpSet:
+				err = txn.SetEntry(newEntry(op.Key, op.Value, op.TTL))
+			case kv.OpDelete:
+				err = txn.Delete(op.Key)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (b *badgerkv) Scan(ctx context.Context, opts kv.ScanOptions, h kv.ScanHandler) error {
+	err := b.db.View(func(txn *badger.Txn) error {
+		return scanTxn(ctx, txn, opts, h)
+	})
+	if err != nil && !errors.Is(err, kv.ErrStopScan) {
+		return err
+	}
+
+	return nil
+}
+
+// scanTxn checks ctx before every item, so a cancelled scan releases
+// the read transaction promptly.
+func scanTxn(ctx context.Context, txn *badger.Txn, opts kv.ScanOptions, h kv.ScanHandler) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	limit := -1
 	if opts.Limit != 0 {
 		limit = opts.Limit
 	}
 
-	err := b.db.View(func(txn *badger.Txn) error {
-		opt := badger.DefaultIteratorOptions
+	lower, upper := opts.Bounds()
+
+	opt := badger.DefaultIteratorOptions
+	opt.Reverse = opts.Reverse
+	if !opts.Reverse {
+		// In reverse mode the iterator is positioned at the upper
+		// bound first, which does not share the prefix.
 		opt.Prefix = opts.Prefix
-		it := txn.NewIterator(opt)
-		defer it.Close()
+	}
+	it := txn.NewIterator(opt)
+	defer it.Close()
 
-		for it.Seek(opts.Prefix); it.Valid(); it.Next() {
-			limit--
-			item := it.Item()
+	switch {
+	case !opts.Reverse:
+		it.Seek(lower)
+	case upper == nil:
+		it.Rewind()
+	default:
+		it.Seek(upper)
+	}
 
-			key := item.Key()
-			err := item.Value(func(val []byte) error {
-				return h(key, val)
-			})
-			if err != nil {
-				return err
-			}
+	for ; it.Valid(); it.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		item := it.Item()
+		key := item.Key()
 
-			if limit == 0 {
+		if opts.Reverse {
+			if upper != nil && bytes.Equal(key, upper) {
+				continue
+			}
+			if bytes.Compare(key, lower) < 0 {
 				break
 			}
+		} else if upper != nil && bytes.Compare(key, upper) >= 0 {
+			break
 		}
-		return nil
-	})
-	if err != nil && !errors.Is(err, kv.ErrStopScan) {
-		return err
-	}
 
+		limit--
+		err := item.Value(func(val []byte) error {
+			return h(key, val)
+		})
+		if err != nil {
+			return err
+		}
+
+		if limit == 0 {
+			break
+		}
+	}
 	return nil
 }
+
+func (b *badgerkv) Close() error {
+	b.stopWatch()
+	<-b.watchDone
+	b.hub.Close()
+	b.stopCollecting()
+	if b.deps.Registry != nil {
+		b.deps.Registry.Unregister(b.metrics)
+	}
+	return b.db.Close()
+}
@@ -1,22 +1,179 @@
 package badgerkv
 
 import (
+	"bytes"
+	"context"
 	"kvstore/internal/storeservice/store/kv"
 	"kvstore/internal/storeservice/store/kvtests"
+	"kvstore/internal/storeservice/store/mapkv"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
 
-func setupTestSuite(t *testing.T) (kv.Store, error) {
+func setupTestSuite(tb testing.TB) (kv.Store, error) {
 	return New(
-		Config{Root: t.TempDir(), InMem: true},
+		Config{Root: tb.TempDir(), InMem: true},
 		Dependencies{Log: logrus.StandardLogger()})
 }
 
+func newTestStore(tb testing.TB) kv.Store {
+	s, err := setupTestSuite(tb)
+	require.NoError(tb, err)
+	return s
+}
+
 func TestBadger(t *testing.T) {
+	kvtests.RunTests(t, newTestStore)
+}
+
+func FuzzBadger(f *testing.F) {
+	kvtests.Fuzz(f, newTestStore)
+}
+
+func TestRestoreDump(t *testing.T) {
+	ctx := context.Background()
+
+	src := mapkv.NewStore()
+	defer src.Close()
+	require.NoError(t, src.Set(ctx, kv.Key("a"), kv.Value("1")))
+	require.NoError(t, src.SetWithTTL(ctx, kv.Key("b"), kv.Value("2"), time.Hour))
+
+	var buf bytes.Buffer
+	_, err := src.Backup(ctx, &buf, 0)
+	require.NoError(t, err)
+
 	s, err := setupTestSuite(t)
 	require.NoError(t, err)
-	kvtests.RunTests(t, s)
+	defer s.Close()
+	require.NoError(t, s.Restore(ctx, &buf))
+
+	val, err := s.Get(ctx, kv.Key("a"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("1"), val)
+	val, err = s.Get(ctx, kv.Key("b"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("2"), val)
+
+	// Native backups can only be restored by badger.
+	buf.Reset()
+	_, err = s.Backup(ctx, &buf, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, src.Restore(ctx, &buf), kv.ErrUnsupportedBackup)
+}
+
+func TestRestoreInvalid(t *testing.T) {
+	s, err := setupTestSuite(t)
+	require.NoError(t, err)
+	defer s.Close()
+
+	err = s.Restore(context.Background(), bytes.NewReader([]byte("not a backup at all")))
+	require.ErrorIs(t, err, kv.ErrUnsupportedBackup)
+}
+
+func TestPersistence(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		cfg  = Config{Root: t.TempDir()}
+		deps = Dependencies{Log: logrus.StandardLogger()}
+	)
+
+	s, err := New(cfg, deps)
+	require.NoError(t, err)
+	require.NoError(t, s.Set(ctx, kv.Key("key"), kv.Value("value")))
+	require.NoError(t, s.Close())
+
+	s, err = New(cfg, deps)
+	require.NoError(t, err)
+	defer s.Close()
+
+	val, err := s.Get(ctx, kv.Key("key"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("value"), val)
+}
+
+func TestOpenLocked(t *testing.T) {
+	var (
+		cfg  = Config{Root: t.TempDir()}
+		deps = Dependencies{Log: logrus.StandardLogger()}
+	)
+
+	s, err := New(cfg, deps)
+	require.NoError(t, err)
+	defer s.Close()
+
+	_, err = New(cfg, deps)
+	require.Error(t, err)
+}
+
+func TestOpenCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "MANIFEST"), []byte("definitely not a manifest"), 0o600)
+	require.NoError(t, err)
+
+	_, err = New(Config{Root: dir}, Dependencies{Log: logrus.StandardLogger()})
+	require.Error(t, err)
+}
+
+func TestEncryptionKey(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		key  = bytes.Repeat([]byte{0x42}, 32)
+		cfg  = Config{Root: t.TempDir(), EncryptionKey: key}
+		deps = Dependencies{Log: logrus.StandardLogger()}
+	)
+
+	s, err := New(cfg, deps)
+	require.NoError(t, err)
+	require.NoError(t, s.Set(ctx, kv.Key("key"), kv.Value("plaintext value")))
+	require.NoError(t, s.Close())
+
+	err = filepath.Walk(cfg.Root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "plaintext value", path)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The database cannot be opened without its key or with another one.
+	_, err = New(Config{Root: cfg.Root}, deps)
+	require.Error(t, err)
+	_, err = New(Config{Root: cfg.Root, EncryptionKey: bytes.Repeat([]byte{0x43}, 32)}, deps)
+	require.Error(t, err)
+
+	s, err = New(cfg, deps)
+	require.NoError(t, err)
+	defer s.Close()
+
+	val, err := s.Get(ctx, kv.Key("key"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("plaintext value"), val)
+}
+
+func TestReadEncryptionKey(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	key, err := readEncryptionKey(write("ok", "000102030405060708090a0b0c0d0e0f\n"))
+	require.NoError(t, err)
+	require.Len(t, key, 16)
+
+	_, err = readEncryptionKey(write("short", "0001"))
+	require.Error(t, err)
+	_, err = readEncryptionKey(write("hex", "not hex at all"))
+	require.Error(t, err)
+	_, err = readEncryptionKey(filepath.Join(dir, "missing"))
+	require.Error(t, err)
 }
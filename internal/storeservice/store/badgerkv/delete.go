@@ -0,0 +1,81 @@
+package badgerkv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// deleteBatchSize is the number of keys deleted by one transaction,
+// which is cut short when badger finds it too big.
+const deleteBatchSize = 10000
+
+// DeletePrefix deletes the keys in transactions rather than with
+// DropPrefix, which blocks all writes and is not seen by watchers.
+func (b *badgerkv) DeletePrefix(ctx context.Context, prefix kv.Key) (int64, error) {
+	return b.deleteRange(ctx, kv.ScanOptions{Prefix: prefix})
+}
+
+func (b *badgerkv) DeleteRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	return b.deleteRange(ctx, kv.ScanOptions{Start: start, End: end})
+}
+
+func (b *badgerkv) deleteRange(ctx context.Context, opts kv.ScanOptions) (int64, error) {
+	lower, upper := opts.Bounds()
+
+	var count int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+
+		var (
+			n    int64
+			more bool
+		)
+		err := b.db.Update(func(txn *badger.Txn) error {
+			n, more = 0, false
+
+			opt := badger.DefaultIteratorOptions
+			opt.PrefetchValues = false
+			it := txn.NewIterator(opt)
+			defer it.Close()
+
+			for it.Seek(lower); it.Valid(); it.Next() {
+				key := it.Item().KeyCopy(nil)
+				if upper != nil && bytes.Compare(key, upper) >= 0 {
+					break
+				}
+				if n == deleteBatchSize {
+					more = true
+					break
+				}
+
+				err := txn.Delete(key)
+				if errors.Is(err, badger.ErrTxnTooBig) {
+					more = true
+					break
+				} else if err != nil {
+					return err
+				}
+				n++
+			}
+			return nil
+		})
+		if errors.Is(err, badger.ErrConflict) {
+			// A key of the batch was written meanwhile, the batch is
+			// read again.
+			continue
+		} else if err != nil {
+			return count, err
+		}
+
+		count += n
+		if !more {
+			return count, nil
+		}
+	}
+}
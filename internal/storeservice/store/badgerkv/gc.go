@@ -0,0 +1,257 @@
+package badgerkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// defaultDiscardRatio is used by Compact when the scheduled
+	// collection is not configured.
+	defaultDiscardRatio = 0.5
+	// flattenWorkers is the number of concurrent compactions Compact
+	// runs per level.
+	flattenWorkers = 2
+)
+
+const (
+	triggerScheduled = "scheduled"
+	triggerCompact   = "compact"
+)
+
+// QuietHours is a daily window of local time, the zero value is the
+// whole day.
+type QuietHours struct {
+	// Start and End are offsets from midnight, End before Start spans
+	// midnight.
+	Start, End time.Duration
+}
+
+// ParseQuietHours parses a window such as "22:00-06:00", empty is the
+// whole day.
+func ParseQuietHours(s string) (QuietHours, error) {
+	if s == "" {
+		return QuietHours{}, nil
+	}
+
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return QuietHours{}, fmt.Errorf("quiet hours %q: want HH:MM-HH:MM", s)
+	}
+
+	var (
+		q   QuietHours
+		err error
+	)
+	if q.Start, err = parseClock(start); err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours %q: %w", s, err)
+	}
+	if q.End, err = parseClock(end); err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours %q: %w", s, err)
+	}
+	return q, nil
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (q QuietHours) Contains(t time.Time) bool {
+	if q.Start == q.End {
+		return true
+	}
+
+	h, m, s := t.Clock()
+	now := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+	if q.Start < q.End {
+		return now >= q.Start && now < q.End
+	}
+	return now >= q.Start || now < q.End
+}
+
+type gcMetrics struct {
+	runs      *prometheus.CounterVec
+	failures  *prometheus.CounterVec
+	reclaimed *prometheus.CounterVec
+}
+
+func newGCMetrics() *gcMetrics {
+	return &gcMetrics{
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "badger_gc_runs_total",
+			Help: "Value log garbage collections and compactions by trigger.",
+		}, []string{"trigger"}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "badger_gc_failures_total",
+			Help: "Failed value log garbage collections and compactions by trigger.",
+		}, []string{"trigger"}),
+		reclaimed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "badger_gc_reclaimed_bytes_total",
+			Help: "Disk space freed by value log garbage collections and compactions.",
+		}, []string{"trigger"}),
+	}
+}
+
+func (m *gcMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.runs.Describe(ch)
+	m.failures.Describe(ch)
+	m.reclaimed.Describe(ch)
+}
+
+func (m *gcMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.runs.Collect(ch)
+	m.failures.Collect(ch)
+	m.reclaimed.Collect(ch)
+}
+
+func (m *gcMetrics) observe(trigger string, reclaimed int64, err error) {
+	m.runs.WithLabelValues(trigger).Inc()
+	if err != nil {
+		m.failures.WithLabelValues(trigger).Inc()
+	}
+	m.reclaimed.WithLabelValues(trigger).Add(float64(reclaimed))
+}
+
+// startGC starts the scheduled collection. An in-memory database has no
+// value log to collect.
+func (b *badgerkv) startGC() {
+	b.stopGC = make(chan struct{})
+	b.gcDone = make(chan struct{})
+	if b.cfg.GCInterval <= 0 || b.cfg.InMem {
+		close(b.gcDone)
+		return
+	}
+	go b.collectLoop()
+}
+
+// stopCollecting stops the scheduled collection and waits for a running
+// compaction, later compactions fail with kv.ErrClosed.
+func (b *badgerkv) stopCollecting() {
+	close(b.stopGC)
+	<-b.gcDone
+
+	b.gcMu.Lock()
+	defer b.gcMu.Unlock()
+	b.gcClosed = true
+}
+
+func (b *badgerkv) collectLoop() {
+	defer close(b.gcDone)
+
+	ticker := time.NewTicker(b.cfg.GCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stopGC:
+			return
+		case now := <-ticker.C:
+			if !b.cfg.GCQuietHours.Contains(now) {
+				continue
+			}
+
+			b.gcMu.Lock()
+			reclaimed, err := b.collectGarbage(context.Background(), b.cfg.GCDiscardRatio)
+			b.gcMu.Unlock()
+			if errors.Is(err, kv.ErrClosed) {
+				return
+			}
+
+			b.metrics.observe(triggerScheduled, reclaimed, err)
+			if err != nil {
+				b.deps.Log.WithError(err).Error("value log garbage collection failed")
+			} else if reclaimed > 0 {
+				b.deps.Log.WithField("reclaimed", reclaimed).Info("value log garbage collected")
+			}
+		}
+	}
+}
+
+// Compact flattens the LSM tree, which also updates the statistics of
+// garbage in the value log, and then collects the value log.
+func (b *badgerkv) Compact(ctx context.Context) (int64, error) {
+	b.gcMu.Lock()
+	defer b.gcMu.Unlock()
+
+	if b.gcClosed {
+		return 0, kv.ErrClosed
+	}
+
+	reclaimed, err := b.compact(ctx)
+	b.metrics.observe(triggerCompact, reclaimed, err)
+	return reclaimed, err
+}
+
+func (b *badgerkv) compact(ctx context.Context) (int64, error) {
+	before, err := b.diskUsage()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := b.db.Flatten(flattenWorkers); err != nil {
+		return 0, err
+	}
+
+	if !b.cfg.InMem {
+		ratio := b.cfg.GCDiscardRatio
+		if ratio == 0 {
+			ratio = defaultDiscardRatio
+		}
+		if _, err := b.collectGarbage(ctx, ratio); err != nil {
+			return 0, err
+		}
+	}
+
+	after, err := b.diskUsage()
+	if err != nil {
+		return 0, err
+	}
+	return max(before.total-after.total, 0), nil
+}
+
+// collectGarbage rewrites value log files until none has enough garbage
+// and returns the disk space it freed. It must be called with b.gcMu
+// held.
+func (b *badgerkv) collectGarbage(ctx context.Context, discardRatio float64) (int64, error) {
+	before, err := b.diskUsage()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		err := b.db.RunValueLogGC(discardRatio)
+		if errors.Is(err, badger.ErrNoRewrite) {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+
+		// Every round rewrites one file, shutdown does not wait for
+		// the rest.
+		select {
+		case <-b.stopGC:
+			return 0, kv.ErrClosed
+		default:
+		}
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	after, err := b.diskUsage()
+	if err != nil {
+		return 0, err
+	}
+	return max(before.total-after.total, 0), nil
+}
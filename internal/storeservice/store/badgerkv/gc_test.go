@@ -0,0 +1,120 @@
+package badgerkv
+
+import (
+	"context"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHours(t *testing.T) {
+	at := func(clock string) time.Time {
+		ts, err := time.ParseInLocation("15:04", clock, time.Local)
+		require.NoError(t, err)
+		return ts
+	}
+
+	cases := []struct {
+		window string
+		in     []string
+		out    []string
+	}{
+		{window: "", in: []string{"00:00", "12:00", "23:59"}},
+		{window: "01:00-05:00", in: []string{"01:00", "04:59"}, out: []string{"00:59", "05:00", "13:00"}},
+		{window: "22:00-06:00", in: []string{"22:00", "23:59", "00:00", "05:59"}, out: []string{"06:00", "21:59", "12:00"}},
+	}
+	for _, c := range cases {
+		q, err := ParseQuietHours(c.window)
+		require.NoError(t, err)
+		for _, clock := range c.in {
+			require.True(t, q.Contains(at(clock)), "%s in %s", clock, c.window)
+		}
+		for _, clock := range c.out {
+			require.False(t, q.Contains(at(clock)), "%s in %s", clock, c.window)
+		}
+	}
+
+	for _, window := range []string{"01:00", "1-5", "01:00-25:00"} {
+		_, err := ParseQuietHours(window)
+		require.Error(t, err, window)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cfg = Config{
+			Root:             t.TempDir(),
+			ValueLogFileSize: 1 << 20,
+			ValueThreshold:   1 << 10,
+		}
+		deps = Dependencies{
+			Log:      logrus.StandardLogger(),
+			Registry: prometheus.NewRegistry(),
+		}
+	)
+
+	// Every value is overwritten, so most of the value log is garbage.
+	// Closing flushes the memtable, more tables than level 0 holds make
+	// the compaction which tells the garbage in the value log.
+	const rounds = 6
+	for round := 0; round < rounds; round++ {
+		s, err := New(cfg, deps)
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			value := make(kv.Value, 16<<10)
+			value[0] = byte(round)
+			err := s.Set(ctx, kv.Key(fmt.Sprintf("key/%03d", i)), value)
+			require.NoError(t, err)
+		}
+		require.NoError(t, s.Close())
+	}
+
+	s, err := New(cfg, deps)
+	require.NoError(t, err)
+	defer s.Close()
+
+	before, err := s.Stats(ctx)
+	require.NoError(t, err)
+
+	reclaimed, err := s.(kv.Compactor).Compact(ctx)
+	require.NoError(t, err)
+	require.Positive(t, reclaimed)
+
+	after, err := s.Stats(ctx)
+	require.NoError(t, err)
+	require.Less(t, after.VlogBytes, before.VlogBytes)
+	require.Equal(t, before.Keys, after.Keys)
+
+	val, err := s.Get(ctx, kv.Key("key/042"))
+	require.NoError(t, err)
+	require.Equal(t, byte(rounds-1), val[0])
+
+	metrics := s.(*badgerkv).metrics
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.runs.WithLabelValues(triggerCompact)))
+	require.Equal(t, float64(reclaimed), testutil.ToFloat64(metrics.reclaimed.WithLabelValues(triggerCompact)))
+}
+
+func TestCloseStopsGC(t *testing.T) {
+	s, err := New(Config{
+		Root:           t.TempDir(),
+		GCInterval:     time.Millisecond,
+		GCDiscardRatio: 0.5,
+	}, Dependencies{Log: logrus.StandardLogger()})
+	require.NoError(t, err)
+
+	b := s.(*badgerkv)
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(b.metrics.runs.WithLabelValues(triggerScheduled)) > 0
+	}, 5*time.Second, time.Millisecond)
+
+	require.NoError(t, s.Close())
+	_, err = b.Compact(context.Background())
+	require.ErrorIs(t, err, kv.ErrClosed)
+}
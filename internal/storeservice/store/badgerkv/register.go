@@ -0,0 +1,101 @@
+package badgerkv
+
+import (
+	"encoding/hex"
+	"fmt"
+	"kvstore/internal/storeservice/store"
+	"kvstore/internal/storeservice/store/kv"
+	"os"
+	"strings"
+)
+
+func init() {
+	store.Register(store.Backend{
+		Name:  "badger",
+		Usage: "persistent LSM tree storage based on badger",
+		Options: []store.Option{
+			{Name: "dir", Usage: "directory of the database", Default: "data/store"},
+			{Name: "in-memory", Usage: "keep all data in memory, nothing survives a restart", Default: "false"},
+			{Name: "sync-writes", Usage: "sync every write to disk before acknowledging it", Default: "false"},
+			{Name: "value-log-file-size", Usage: "maximum size of a value log file in bytes, 0 keeps the badger default", Default: "0"},
+			{Name: "block-cache-size", Usage: "block cache size in bytes, 0 keeps the badger default", Default: "0"},
+			{Name: "index-cache-size", Usage: "index cache size in bytes, 0 keeps the badger default", Default: "0"},
+			{Name: "value-threshold", Usage: "size in bytes from which values go to the value log, 0 keeps the badger default", Default: "0"},
+			{Name: "gc-interval", Usage: "how often the value log is garbage collected, 0 disables it", Default: "10m"},
+			{Name: "gc-discard-ratio", Usage: "part of a value log file which must be garbage to rewrite it", Default: "0.5"},
+			{Name: "encryption-key-file", Usage: "file with the hex encoded AES key encrypting the whole database, empty disables the encryption", Default: ""},
+			{Name: "encryption-key-rotation", Usage: "how often badger replaces its data keys, 0 keeps the badger default", Default: "0"},
+			{Name: "gc-quiet-hours", Usage: "daily window of local time for the garbage collection such as 01:00-05:00, empty is any time", Default: ""},
+		},
+		New: newFromOptions,
+		TestOptions: func(dir string) store.Options {
+			return store.Options{"dir": dir}
+		},
+	})
+}
+
+func newFromOptions(opts store.Options, deps store.Dependencies) (kv.Store, error) {
+	var (
+		cfg = Config{Root: opts.String("dir")}
+		err error
+	)
+	if cfg.InMem, err = opts.Bool("in-memory"); err != nil {
+		return nil, err
+	}
+	if cfg.SyncWrites, err = opts.Bool("sync-writes"); err != nil {
+		return nil, err
+	}
+	if cfg.ValueLogFileSize, err = opts.Int64("value-log-file-size"); err != nil {
+		return nil, err
+	}
+	if cfg.BlockCacheSize, err = opts.Int64("block-cache-size"); err != nil {
+		return nil, err
+	}
+	if cfg.IndexCacheSize, err = opts.Int64("index-cache-size"); err != nil {
+		return nil, err
+	}
+	if cfg.ValueThreshold, err = opts.Int64("value-threshold"); err != nil {
+		return nil, err
+	}
+	if path := opts.String("encryption-key-file"); path != "" {
+		if cfg.EncryptionKey, err = readEncryptionKey(path); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.EncryptionKeyRotation, err = opts.Duration("encryption-key-rotation"); err != nil {
+		return nil, err
+	}
+	if cfg.GCInterval, err = opts.Duration("gc-interval"); err != nil {
+		return nil, err
+	}
+	if cfg.GCDiscardRatio, err = opts.Float64("gc-discard-ratio"); err != nil {
+		return nil, err
+	}
+	if cfg.GCQuietHours, err = ParseQuietHours(opts.String("gc-quiet-hours")); err != nil {
+		return nil, err
+	}
+
+	return New(cfg, Dependencies{
+		Log:      deps.Log,
+		Registry: deps.Registry,
+	})
+}
+
+// readEncryptionKey reads a hex encoded AES key, surrounding whitespace
+// is ignored.
+func readEncryptionKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read encryption key: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("encryption key %s: %w", path, err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	}
+	return nil, fmt.Errorf("encryption key %s: %d bytes, want 16, 24 or 32", path, len(key))
+}
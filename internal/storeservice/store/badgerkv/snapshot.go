@@ -0,0 +1,48 @@
+package badgerkv
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// snapshot is a read-only transaction, its read timestamp is fixed when
+// it is created.
+type snapshot struct {
+	txn *badger.Txn
+}
+
+func (b *badgerkv) Snapshot(ctx context.Context) (kv.Snapshot, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &snapshot{
+		txn: b.db.NewTransaction(false),
+	}, nil
+}
+
+func (s *snapshot) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	val, _, _, err := getItem(s.txn, k)
+	return val, err
+}
+
+func (s *snapshot) Scan(ctx context.Context, opts kv.ScanOptions, h kv.ScanHandler) error {
+	err := scanTxn(ctx, s.txn, opts, h)
+	if err != nil && !errors.Is(err, kv.ErrStopScan) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *snapshot) Close() error {
+	s.txn.Discard()
+	return nil
+}
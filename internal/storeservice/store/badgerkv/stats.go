@@ -0,0 +1,117 @@
+package badgerkv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"kvstore/internal/storeservice/store/kv"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func (b *badgerkv) Count(ctx context.Context, prefix kv.Key) (int64, error) {
+	return b.count(ctx, kv.ScanOptions{Prefix: prefix})
+}
+
+func (b *badgerkv) CountRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	return b.count(ctx, kv.ScanOptions{Start: start, End: end})
+}
+
+func (b *badgerkv) count(ctx context.Context, opts kv.ScanOptions) (int64, error) {
+	var count int64
+	err := b.measure(ctx, opts, func(*badger.Item) {
+		count++
+	})
+	return count, err
+}
+
+func (b *badgerkv) Stats(ctx context.Context) (kv.Stats, error) {
+	var stats kv.Stats
+	err := b.measure(ctx, kv.ScanOptions{}, func(item *badger.Item) {
+		stats.Keys++
+		stats.LogicalBytes += item.KeySize() + item.ValueSize()
+	})
+	if err != nil {
+		return kv.Stats{}, err
+	}
+
+	usage, err := b.diskUsage()
+	if err != nil {
+		return kv.Stats{}, err
+	}
+	stats.DiskBytes = usage.total
+	stats.LSMBytes = usage.lsm
+	stats.VlogBytes = usage.vlog
+	return stats, nil
+}
+
+// measure calls f with the live items within the bounds of opts. Values
+// are not fetched, the items only tell their sizes.
+func (b *badgerkv) measure(ctx context.Context, opts kv.ScanOptions, f func(*badger.Item)) error {
+	lower, upper := opts.Bounds()
+
+	return b.db.View(func(txn *badger.Txn) error {
+		opt := badger.DefaultIteratorOptions
+		opt.PrefetchValues = false
+		opt.Prefix = opts.Prefix
+		it := txn.NewIterator(opt)
+		defer it.Close()
+
+		for it.Seek(lower); it.Valid(); it.Next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			item := it.Item()
+			if upper != nil && bytes.Compare(item.Key(), upper) >= 0 {
+				break
+			}
+			f(item)
+		}
+		return nil
+	})
+}
+
+type diskUsage struct {
+	total, lsm, vlog int64
+}
+
+// diskUsage takes the file sizes from the directory, badger's own Size
+// is refreshed only once a minute.
+func (b *badgerkv) diskUsage() (diskUsage, error) {
+	var usage diskUsage
+	if b.cfg.InMem {
+		return usage, nil
+	}
+
+	files, err := os.ReadDir(b.cfg.Root)
+	if err != nil {
+		return diskUsage{}, err
+	}
+	for _, f := range files {
+		info, err := f.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			// Removed by a compaction meanwhile.
+			continue
+		} else if err != nil {
+			return diskUsage{}, err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		// Value logs and memtables are preallocated, their size is not
+		// the space they take.
+		size := kv.DiskSize(info)
+		usage.total += size
+		switch filepath.Ext(f.Name()) {
+		case ".sst":
+			usage.lsm += size
+		case ".vlog":
+			usage.vlog += size
+		}
+	}
+	return usage, nil
+}
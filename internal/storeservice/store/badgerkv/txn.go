@@ -0,0 +1,88 @@
+package badgerkv
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+type txn struct {
+	txn    *badger.Txn
+	closed bool
+}
+
+func (b *badgerkv) Begin(ctx context.Context) (kv.Txn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &txn{
+		txn: b.db.NewTransaction(true),
+	}, nil
+}
+
+func (t *txn) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	if t.closed {
+		return nil, kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	val, _, _, err := getItem(t.txn, k)
+	return val, err
+}
+
+func (t *txn) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	return t.SetWithTTL(ctx, k, v, 0)
+}
+
+func (t *txn) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return t.txn.SetEntry(newEntry(k, v, ttl))
+}
+
+func (t *txn) Delete(ctx context.Context, k kv.Key) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return t.txn.Delete(k)
+}
+
+func (t *txn) Commit(ctx context.Context) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+
+	t.closed = true
+	if err := ctx.Err(); err != nil {
+		t.txn.Discard()
+		return err
+	}
+	err := t.txn.Commit()
+	if errors.Is(err, badger.ErrConflict) {
+		return kv.ErrConflict
+	}
+	return err
+}
+
+func (t *txn) Rollback() error {
+	if !t.closed {
+		t.closed = true
+		t.txn.Discard()
+	}
+	return nil
+}
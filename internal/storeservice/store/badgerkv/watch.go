@@ -0,0 +1,105 @@
+package badgerkv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/watch"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/badger/v4/pb"
+)
+
+// badgerPrefix starts the keys badger keeps for itself.
+var badgerPrefix = []byte("!badger!")
+
+// startWatch subscribes to every write of the database and publishes it
+// to the watchers. A single subscription serves all of them: Subscribe
+// gives no signal once it is registered, so a subscription made per
+// watch could miss writes made right after the watch started. This one
+// is made when the store opens, before it serves any request.
+func (b *badgerkv) startWatch() {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.hub = watch.NewHub()
+	b.stopWatch = cancel
+	b.watchDone = make(chan struct{})
+
+	go func() {
+		defer close(b.watchDone)
+
+		err := b.db.Subscribe(ctx, b.publish, []pb.Match{{}})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			b.deps.Log.WithError(err).Error("badger subscription ended, watches get no more events")
+		}
+	}()
+}
+
+func (b *badgerkv) publish(list *badger.KVList) error {
+	if !b.hub.Watching() {
+		return nil
+	}
+
+	events := make([]kv.Event, 0, len(list.Kv))
+	for _, item := range list.Kv {
+		if bytes.HasPrefix(item.Key, badgerPrefix) {
+			continue
+		}
+
+		e := kv.Event{
+			Type:    kv.EventDelete,
+			Key:     item.Key,
+			Version: item.Version,
+		}
+		if len(item.Meta) > 0 && item.Meta[0]&userMetaValue != 0 {
+			e.Type = kv.EventPut
+			e.Value = item.Value
+		}
+		events = append(events, e)
+	}
+
+	b.hub.Publish(events...)
+	return nil
+}
+
+func (b *badgerkv) Watch(ctx context.Context, prefix kv.Key, fromVersion uint64, f kv.WatchHandler) error {
+	return b.hub.Watch(ctx, prefix, fromVersion, b.catchUp, f)
+}
+
+// catchUp reads the keys in a read-only transaction, the events up to
+// its read timestamp are in the state it read.
+func (b *badgerkv) catchUp(ctx context.Context, prefix kv.Key, fromVersion uint64, emit kv.WatchHandler) (uint64, error) {
+	txn := b.db.NewTransaction(false)
+	defer txn.Discard()
+
+	opt := badger.DefaultIteratorOptions
+	opt.Prefix = prefix
+	it := txn.NewIterator(opt)
+	defer it.Close()
+
+	for it.Seek(prefix); it.Valid(); it.Next() {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		item := it.Item()
+		if item.Version() <= fromVersion {
+			continue
+		}
+
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return 0, err
+		}
+		err = emit(kv.Event{
+			Type:    kv.EventPut,
+			Key:     item.KeyCopy(nil),
+			Value:   value,
+			Version: item.Version(),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return txn.ReadTs(), nil
+}
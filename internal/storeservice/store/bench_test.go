@@ -0,0 +1,131 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"kvstore/internal/storeservice/store"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvtests"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+const benchKeys = 10000
+
+// benchBackends runs f against every registered backend opened with its
+// default options in a temporary directory.
+func benchBackends(b *testing.B, f func(*testing.B, kv.Store)) {
+	for _, backend := range store.Backends() {
+		b.Run(backend.Name, func(b *testing.B) {
+			s := openBench(b, backend)
+			defer s.Close()
+
+			f(b, s)
+		})
+	}
+}
+
+func openBench(tb testing.TB, backend store.Backend) kv.Store {
+	log := logrus.New()
+	log.SetLevel(logrus.WarnLevel)
+
+	opts := store.Options{}
+	for _, o := range backend.Options {
+		if o.Name == "dir" {
+			opts["dir"] = tb.TempDir()
+		}
+	}
+
+	s, err := store.New(store.Config{Backend: backend.Name, Options: opts}, store.Dependencies{Log: log})
+	require.NoError(tb, err)
+	return s
+}
+
+func benchKey(i int) kv.Key {
+	return kv.Key(fmt.Sprintf("bench/%08d", i%benchKeys))
+}
+
+func fill(b *testing.B, s kv.Store) {
+	value := make(kv.Value, 100)
+	for i := 0; i < benchKeys; i++ {
+		require.NoError(b, s.Set(context.Background(), benchKey(i), value))
+	}
+}
+
+func BenchmarkSet(b *testing.B) {
+	benchBackends(b, func(b *testing.B, s kv.Store) {
+		ctx := context.Background()
+		value := make(kv.Value, 100)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if err := s.Set(ctx, benchKey(i), value); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	benchBackends(b, func(b *testing.B, s kv.Store) {
+		ctx := context.Background()
+		fill(b, s)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := s.Get(ctx, benchKey(i)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkScan(b *testing.B) {
+	benchBackends(b, func(b *testing.B, s kv.Store) {
+		ctx := context.Background()
+		fill(b, s)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			opts := kv.ScanOptions{Start: benchKey(i), Limit: 100}
+			err := s.Scan(ctx, opts, func(kv.Key, kv.Value) error { return nil })
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkWrite(b *testing.B) {
+	benchBackends(b, func(b *testing.B, s kv.Store) {
+		var (
+			ctx   = context.Background()
+			value = make(kv.Value, 100)
+			ops   = make([]kv.Op, 10)
+		)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range ops {
+				ops[j] = kv.Op{Type: kv.OpSet, Key: benchKey(i*len(ops) + j), Value: value}
+			}
+			if err := s.Write(ctx, ops); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkWorkloads runs the standard workloads, which the store bench
+// command runs against a live store too.
+func BenchmarkWorkloads(b *testing.B) {
+	for _, backend := range store.Backends() {
+		b.Run(backend.Name, func(b *testing.B) {
+			kvtests.RunBenchmarks(b, func(tb testing.TB) kv.Store {
+				return openBench(tb, backend)
+			})
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package bitcask
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvdump"
+	"time"
+)
+
+const restoreBatchSize = 1000
+
+// Backup writes a kvdump of a clone of the keydir. Deleted keys are not
+// tracked, an incremental backup only carries keys written after since.
+func (s *Store) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	snap, err := s.Snapshot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Close()
+
+	s.mu.RLock()
+	version := s.version
+	s.mu.RUnlock()
+
+	dw, err := kvdump.NewWriter(w)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		keydir = snap.(*snapshot).keydir
+		now    = time.Now()
+	)
+	keydir.Ascend(func(it item) bool {
+		if it.version <= since || it.expired(now) {
+			return true
+		}
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+
+		var v kv.Value
+		if v, err = s.readValueLocked(it); err != nil {
+			return false
+		}
+
+		err = dw.Write(kvdump.Entry{
+			Key:       kv.Key(it.key),
+			Value:     v,
+			Version:   it.version,
+			ExpiresAt: it.expiresAt,
+		})
+		return err == nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := dw.Close(); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// Restore only accepts kvdump dumps. Restored entries get new versions.
+func (s *Store) Restore(ctx context.Context, r io.Reader) error {
+	br := bufio.NewReader(r)
+	if !kvdump.IsDump(br) {
+		return kv.ErrUnsupportedBackup
+	}
+
+	dr, err := kvdump.NewReader(br)
+	if err != nil {
+		return err
+	}
+
+	var entries []recordEntry
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		e, err := dr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if !e.ExpiresAt.IsZero() && !time.Now().Before(e.ExpiresAt) {
+			continue
+		}
+		entries = append(entries, recordEntry{key: e.Key, value: e.Value, expiresAt: e.ExpiresAt})
+		if len(entries) == restoreBatchSize {
+			if err := s.restoreBatch(entries); err != nil {
+				return err
+			}
+			entries = entries[:0]
+		}
+	}
+
+	return s.restoreBatch(entries)
+}
+
+func (s *Store) restoreBatch(entries []recordEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(entries)
+}
@@ -0,0 +1,62 @@
+package bitcask
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+)
+
+// deleteBatchSize is the number of tombstones written as one record, so
+// a huge deletion does not make a record too large to read back.
+const deleteBatchSize = 10000
+
+func (s *Store) DeletePrefix(ctx context.Context, prefix kv.Key) (int64, error) {
+	return s.deleteRange(ctx, kv.ScanOptions{Prefix: prefix})
+}
+
+func (s *Store) DeleteRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	return s.deleteRange(ctx, kv.ScanOptions{Start: start, End: end})
+}
+
+// deleteRange holds s.mu for all batches, so readers see either none or
+// all of the keys deleted unless a batch fails. Expired keys get
+// tombstones too, but are not counted. On failure it returns the number
+// of keys removed by the batches written before.
+func (s *Store) deleteRange(ctx context.Context, opts kv.ScanOptions) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		now          = time.Now()
+		lower, upper = opts.Bounds()
+		entries      []recordEntry
+		live         []bool
+		count        int64
+	)
+	s.keydir.AscendGreaterOrEqual(item{key: string(lower)}, func(it item) bool {
+		if upper != nil && it.key >= string(upper) {
+			return false
+		}
+		entries = append(entries, recordEntry{key: kv.Key(it.key), tombstone: true})
+		live = append(live, !it.expired(now))
+		return true
+	})
+
+	for len(entries) > 0 {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+
+		n := min(len(entries), deleteBatchSize)
+		if err := s.write(entries[:n]); err != nil {
+			return count, err
+		}
+		for _, ok := range live[:n] {
+			if ok {
+				count++
+			}
+		}
+		entries, live = entries[n:], live[n:]
+	}
+	return count, nil
+}
@@ -0,0 +1,290 @@
+package bitcask
+
+import (
+	"bufio"
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/google/btree"
+)
+
+func (s *Store) merger() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.cfg.MergeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if !s.needsMerge() {
+				continue
+			}
+			if err := s.merge(); err != nil {
+				s.log.WithError(err).Error("merge")
+			}
+		}
+	}
+}
+
+// Compact merges the files right away, whatever share of them is dead.
+func (s *Store) Compact(context.Context) (int64, error) {
+	before, err := kv.DirSize(s.cfg.Dir)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.merge(); err != nil {
+		return 0, err
+	}
+
+	after, err := kv.DirSize(s.cfg.Dir)
+	if err != nil {
+		return 0, err
+	}
+	return max(before-after, 0), nil
+}
+
+// needsMerge reports whether the share of dead bytes reached the merge
+// ratio.
+func (s *Store) needsMerge() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var total, dead int64
+	for _, f := range s.files {
+		if !f.obsolete {
+			total += f.total
+			dead += f.dead
+		}
+	}
+	return dead > 0 && float64(dead) >= s.cfg.MergeRatio*float64(total)
+}
+
+// merge rewrites the live values of every file into a single new file.
+// The active file is rotated first, so writes go on while the values are
+// copied and the keydir is only switched to the copies which were not
+// overwritten meanwhile.
+func (s *Store) merge() error {
+	s.mergeMu.Lock()
+	defer s.mergeMu.Unlock()
+
+	if s.closed {
+		return kv.ErrClosed
+	}
+
+	// The merged file takes the id between the old files and the new
+	// active file, so replaying the files in order keeps newer writes.
+	s.mu.Lock()
+	mergeID := s.active.id + 1
+	if err := s.rotate(mergeID + 1); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	keydir := s.keydir.Clone()
+	version := s.version
+	old := make(map[uint32]*dataFile)
+	for id, f := range s.files {
+		if id < mergeID && !f.obsolete {
+			old[id] = f
+		}
+	}
+	s.mu.Unlock()
+
+	hints, expired, size, err := s.writeMerged(mergeID, keydir, old)
+	if err != nil {
+		os.Remove(filePath(s.cfg.Dir, mergeID, mergeSuffix))
+		os.Remove(filePath(s.cfg.Dir, mergeID, hintSuffix+tmpSuffix))
+		return err
+	}
+
+	if err := s.commitMerged(mergeID, version, hints); err != nil {
+		return err
+	}
+
+	merged := &dataFile{id: mergeID, size: size}
+	if merged.r, err = os.Open(filePath(s.cfg.Dir, mergeID, dataSuffix)); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.files[mergeID] = merged
+	for _, h := range hints {
+		it := item{
+			key:       h.key,
+			fileID:    mergeID,
+			offset:    h.offset,
+			size:      h.size,
+			version:   h.version,
+			expiresAt: h.expiresAt,
+		}
+		merged.total += it.entrySize()
+
+		if cur, ok := s.keydir.Get(item{key: h.key}); ok && cur.version == h.version && old[cur.fileID] != nil {
+			s.keydir.ReplaceOrInsert(it)
+		} else {
+			merged.dead += it.entrySize()
+		}
+	}
+	for _, it := range expired {
+		if cur, ok := s.keydir.Get(it); ok && cur.version == it.version && old[cur.fileID] != nil {
+			s.keydir.Delete(it)
+		}
+	}
+
+	for _, f := range old {
+		f.obsolete = true
+	}
+	s.removeObsolete()
+	return nil
+}
+
+// writeMerged copies the live values stored in the old files into the
+// merge file and returns their hints and the expired items it dropped.
+func (s *Store) writeMerged(mergeID uint32, keydir *btree.BTreeG[item], old map[uint32]*dataFile) ([]hintEntry, []item, int64, error) {
+	f, err := os.OpenFile(filePath(s.cfg.Dir, mergeID, mergeSuffix), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, fileMode)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer f.Close()
+
+	var (
+		w       = bufio.NewWriter(f)
+		now     = time.Now()
+		offset  int64
+		buf     []byte
+		hints   []hintEntry
+		expired []item
+	)
+	keydir.Ascend(func(it item) bool {
+		src, ok := old[it.fileID]
+		if !ok {
+			return true
+		}
+		if it.expired(now) {
+			expired = append(expired, it)
+			return true
+		}
+
+		value := make([]byte, it.size)
+		if _, err = src.r.ReadAt(value, it.offset); err != nil {
+			return false
+		}
+
+		var offsets []int64
+		buf, offsets = appendRecord(buf[:0], it.version, []recordEntry{{
+			key:       []byte(it.key),
+			value:     value,
+			expiresAt: it.expiresAt,
+		}})
+		if _, err = w.Write(buf); err != nil {
+			return false
+		}
+
+		hints = append(hints, hintEntry{
+			key:       it.key,
+			version:   it.version,
+			expiresAt: it.expiresAt,
+			offset:    offset + offsets[0],
+			size:      it.size,
+		})
+		offset += int64(len(buf))
+		return true
+	})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, nil, 0, err
+	}
+	if err := f.Sync(); err != nil {
+		return nil, nil, 0, err
+	}
+	return hints, expired, offset, nil
+}
+
+// commitMerged writes the hint file and moves the merge file in place.
+// Until the rename, a crash leaves the old files untouched.
+func (s *Store) commitMerged(mergeID uint32, version uint64, hints []hintEntry) error {
+	hintPath := filePath(s.cfg.Dir, mergeID, hintSuffix)
+	if err := writeFileSync(hintPath+tmpSuffix, encodeHint(version, hints)); err != nil {
+		return err
+	}
+	if err := os.Rename(hintPath+tmpSuffix, hintPath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(filePath(s.cfg.Dir, mergeID, mergeSuffix), filePath(s.cfg.Dir, mergeID, dataSuffix)); err != nil {
+		return err
+	}
+	return syncDir(s.cfg.Dir)
+}
+
+// removeObsolete removes the files replaced by merges unless snapshots
+// or transactions may still read them. It must be called with s.mu held.
+func (s *Store) removeObsolete() {
+	if s.pins > 0 {
+		return
+	}
+
+	var ids []uint32
+	for id, f := range s.files {
+		if f.obsolete {
+			ids = append(ids, id)
+		}
+	}
+
+	// Older files go first: a crash in between leaves a suffix of the
+	// history, which still replays to the same state.
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		f := s.files[id]
+		f.close()
+		delete(s.files, id)
+
+		os.Remove(filePath(s.cfg.Dir, id, hintSuffix))
+		if err := os.Remove(filePath(s.cfg.Dir, id, dataSuffix)); err != nil {
+			s.log.WithError(err).WithField("file", id).Warn("remove merged data file")
+		}
+	}
+}
+
+func (s *Store) unpin() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pins--
+	s.removeObsolete()
+}
+
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
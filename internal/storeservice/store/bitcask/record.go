@@ -0,0 +1,226 @@
+package bitcask
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"time"
+)
+
+// A record is a write of one or more entries sharing a version, the CRC
+// covers the whole record so a write is either read back entirely or
+// not at all.
+//
+//	record: crc uint32 | length uint32 | version uint64 | count uint32 | entries
+//	entry:  flags byte | expiresAt int64 | keyLen uint32 | valueLen uint32 | key | value
+//
+// Integers are little endian, expiresAt is in unix nanoseconds and zero
+// means the entry never expires.
+const (
+	recordHeaderSize = 20
+	entryHeaderSize  = 17
+	maxRecordSize    = 1 << 30
+)
+
+const flagTombstone byte = 1 << 0
+
+var (
+	errTornRecord = errors.New("torn record")
+	errFailed     = errors.New("bitcask store failed")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+type recordEntry struct {
+	key       []byte
+	value     []byte
+	tombstone bool
+	expiresAt time.Time
+}
+
+// size is the space taken by the entry in a data file.
+func (e recordEntry) size() int64 {
+	return int64(entryHeaderSize + len(e.key) + len(e.value))
+}
+
+// appendRecord encodes the entries and returns the offsets of their
+// values relative to the start of the record.
+func appendRecord(buf []byte, version uint64, entries []recordEntry) ([]byte, []int64) {
+	start := len(buf)
+	buf = append(buf, make([]byte, recordHeaderSize)...)
+	binary.LittleEndian.PutUint64(buf[start+8:], version)
+	binary.LittleEndian.PutUint32(buf[start+16:], uint32(len(entries)))
+
+	offsets := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		var (
+			flags     byte
+			expiresAt int64
+		)
+		if e.tombstone {
+			flags |= flagTombstone
+		}
+		if !e.expiresAt.IsZero() {
+			expiresAt = e.expiresAt.UnixNano()
+		}
+
+		buf = append(buf, flags)
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(expiresAt))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(e.key)))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(e.value)))
+		buf = append(buf, e.key...)
+		offsets = append(offsets, int64(len(buf)-start))
+		buf = append(buf, e.value...)
+	}
+
+	record := buf[start:]
+	binary.LittleEndian.PutUint32(record[4:], uint32(len(record)-recordHeaderSize))
+	binary.LittleEndian.PutUint32(record[0:], crc32.Checksum(record[4:], crcTable))
+	return buf, offsets
+}
+
+// readRecord reads the next record. It returns io.EOF at the end of the
+// file and errTornRecord when the record is incomplete or damaged.
+func readRecord(r *bufio.Reader) (uint64, []recordEntry, []int64, int64, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, nil, nil, 0, errTornRecord
+		}
+		return 0, nil, nil, 0, err
+	}
+
+	length := binary.LittleEndian.Uint32(header[4:])
+	if length > maxRecordSize {
+		return 0, nil, nil, 0, errTornRecord
+	}
+
+	record := make([]byte, recordHeaderSize+int(length))
+	copy(record, header[:])
+	if _, err := io.ReadFull(r, record[recordHeaderSize:]); err != nil {
+		return 0, nil, nil, 0, errTornRecord
+	}
+	if crc32.Checksum(record[4:], crcTable) != binary.LittleEndian.Uint32(record) {
+		return 0, nil, nil, 0, errTornRecord
+	}
+
+	var (
+		version = binary.LittleEndian.Uint64(record[8:])
+		count   = binary.LittleEndian.Uint32(record[16:])
+		entries = make([]recordEntry, 0, count)
+		offsets = make([]int64, 0, count)
+		pos     = recordHeaderSize
+	)
+	for i := uint32(0); i < count; i++ {
+		if len(record)-pos < entryHeaderSize {
+			return 0, nil, nil, 0, errTornRecord
+		}
+
+		var (
+			flags     = record[pos]
+			expiresAt = int64(binary.LittleEndian.Uint64(record[pos+1:]))
+			keyLen    = int(binary.LittleEndian.Uint32(record[pos+9:]))
+			valueLen  = int(binary.LittleEndian.Uint32(record[pos+13:]))
+		)
+		pos += entryHeaderSize
+		if len(record)-pos < keyLen+valueLen {
+			return 0, nil, nil, 0, errTornRecord
+		}
+
+		e := recordEntry{
+			key:       record[pos : pos+keyLen],
+			value:     record[pos+keyLen : pos+keyLen+valueLen],
+			tombstone: flags&flagTombstone != 0,
+		}
+		if expiresAt != 0 {
+			e.expiresAt = time.Unix(0, expiresAt)
+		}
+		entries = append(entries, e)
+		offsets = append(offsets, int64(pos+keyLen))
+		pos += keyLen + valueLen
+	}
+
+	return version, entries, offsets, int64(len(record)), nil
+}
+
+// A hint file lists the live entries of a data file written by merge,
+// so the keydir is rebuilt without reading the values.
+//
+//	hint:  version uint64 | entries | crc uint32
+//	entry: version uint64 | expiresAt int64 | offset int64 | valueLen uint32 | keyLen uint32 | key
+//
+// The version in the header is the version of the store at the merge.
+const hintEntryHeaderSize = 32
+
+var errInvalidHint = errors.New("invalid hint file")
+
+type hintEntry struct {
+	key       string
+	version   uint64
+	expiresAt time.Time
+	offset    int64
+	size      uint32
+}
+
+func encodeHint(version uint64, entries []hintEntry) []byte {
+	buf := binary.LittleEndian.AppendUint64(nil, version)
+	for _, e := range entries {
+		var expiresAt int64
+		if !e.expiresAt.IsZero() {
+			expiresAt = e.expiresAt.UnixNano()
+		}
+
+		buf = binary.LittleEndian.AppendUint64(buf, e.version)
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(expiresAt))
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(e.offset))
+		buf = binary.LittleEndian.AppendUint32(buf, e.size)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(e.key)))
+		buf = append(buf, e.key...)
+	}
+	return binary.LittleEndian.AppendUint32(buf, crc32.Checksum(buf, crcTable))
+}
+
+func decodeHint(buf []byte) (uint64, []hintEntry, error) {
+	if len(buf) < 12 {
+		return 0, nil, errInvalidHint
+	}
+
+	body := buf[:len(buf)-4]
+	if crc32.Checksum(body, crcTable) != binary.LittleEndian.Uint32(buf[len(buf)-4:]) {
+		return 0, nil, errInvalidHint
+	}
+
+	var (
+		version = binary.LittleEndian.Uint64(body)
+		entries []hintEntry
+		pos     = 8
+	)
+	for pos < len(body) {
+		if len(body)-pos < hintEntryHeaderSize {
+			return 0, nil, errInvalidHint
+		}
+
+		e := hintEntry{
+			version: binary.LittleEndian.Uint64(body[pos:]),
+			offset:  int64(binary.LittleEndian.Uint64(body[pos+16:])),
+			size:    binary.LittleEndian.Uint32(body[pos+24:]),
+		}
+		if expiresAt := int64(binary.LittleEndian.Uint64(body[pos+8:])); expiresAt != 0 {
+			e.expiresAt = time.Unix(0, expiresAt)
+		}
+
+		keyLen := int(binary.LittleEndian.Uint32(body[pos+28:]))
+		pos += hintEntryHeaderSize
+		if len(body)-pos < keyLen {
+			return 0, nil, errInvalidHint
+		}
+		e.key = string(body[pos : pos+keyLen])
+		pos += keyLen
+
+		entries = append(entries, e)
+	}
+
+	return version, entries, nil
+}
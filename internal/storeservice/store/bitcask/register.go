@@ -0,0 +1,46 @@
+package bitcask
+
+import (
+	"kvstore/internal/storeservice/store"
+	"kvstore/internal/storeservice/store/kv"
+	"strconv"
+)
+
+func init() {
+	store.Register(store.Backend{
+		Name:  "bitcask",
+		Usage: "persistent log-structured storage with an in-memory key directory",
+		Options: []store.Option{
+			{Name: "dir", Usage: "directory of the data files", Default: "data/bitcask"},
+			{Name: "max-file-size", Usage: "size in bytes after which a new data file is started", Default: strconv.Itoa(defaultMaxFileSize)},
+			{Name: "sync-writes", Usage: "sync every write to disk before acknowledging it", Default: "false"},
+			{Name: "merge-interval", Usage: "how often the share of dead bytes is checked", Default: defaultMergeInterval.String()},
+			{Name: "merge-ratio", Usage: "share of dead bytes which triggers a merge", Default: strconv.FormatFloat(defaultMergeRatio, 'f', -1, 64)},
+		},
+		New: newFromOptions,
+		TestOptions: func(dir string) store.Options {
+			return store.Options{"dir": dir, "max-file-size": "4096"}
+		},
+	})
+}
+
+func newFromOptions(opts store.Options, deps store.Dependencies) (kv.Store, error) {
+	var (
+		cfg = Config{Dir: opts.String("dir")}
+		err error
+	)
+	if cfg.MaxFileSize, err = opts.Int64("max-file-size"); err != nil {
+		return nil, err
+	}
+	if cfg.SyncWrites, err = opts.Bool("sync-writes"); err != nil {
+		return nil, err
+	}
+	if cfg.MergeInterval, err = opts.Duration("merge-interval"); err != nil {
+		return nil, err
+	}
+	if cfg.MergeRatio, err = opts.Float64("merge-ratio"); err != nil {
+		return nil, err
+	}
+
+	return New(cfg, Dependencies{Log: deps.Log})
+}
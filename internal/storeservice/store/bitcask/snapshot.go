@@ -0,0 +1,62 @@
+package bitcask
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"sync"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// snapshot is a copy-on-write clone of the keydir, it pins the data
+// files so merge does not remove the values it points at. Expiration is
+// evaluated at the moment the snapshot was taken.
+type snapshot struct {
+	s      *Store
+	keydir *btree.BTreeG[item]
+	at     time.Time
+
+	closeOnce sync.Once
+}
+
+func (s *Store) Snapshot(ctx context.Context) (kv.Snapshot, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Clone modifies the original tree, so it needs the exclusive lock.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pins++
+
+	return &snapshot{
+		s:      s,
+		keydir: s.keydir.Clone(),
+		at:     time.Now(),
+	}, nil
+}
+
+func (s *snapshot) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	it, ok := s.keydir.Get(item{key: string(k)})
+	if !ok || it.expired(s.at) {
+		return nil, kv.ErrNotFound
+	}
+
+	return s.s.readValueLocked(it)
+}
+
+// Scan reads every value with the read lock taken on its own, the files
+// are pinned and f runs without the lock.
+func (s *snapshot) Scan(ctx context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
+	return scanKeydir(ctx, s.keydir, opts, s.at, s.s.readValueLocked, f)
+}
+
+func (s *snapshot) Close() error {
+	s.closeOnce.Do(s.s.unpin)
+	return nil
+}
@@ -0,0 +1,63 @@
+package bitcask
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+)
+
+// Count measures a clone of the keydir, the sizes of the values are kept
+// in it, so no value is read.
+func (s *Store) Count(ctx context.Context, prefix kv.Key) (int64, error) {
+	keys, _, err := s.measure(ctx, kv.ScanOptions{Prefix: prefix})
+	return keys, err
+}
+
+func (s *Store) CountRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	keys, _, err := s.measure(ctx, kv.ScanOptions{Start: start, End: end})
+	return keys, err
+}
+
+func (s *Store) Stats(ctx context.Context) (kv.Stats, error) {
+	keys, size, err := s.measure(ctx, kv.ScanOptions{})
+	if err != nil {
+		return kv.Stats{}, err
+	}
+	disk, err := kv.DirSize(s.cfg.Dir)
+	if err != nil {
+		return kv.Stats{}, err
+	}
+
+	return kv.Stats{
+		Keys:         keys,
+		LogicalBytes: size,
+		DiskBytes:    disk,
+	}, nil
+}
+
+// measure returns the number and the total size of the live keys within
+// the bounds of opts.
+func (s *Store) measure(ctx context.Context, opts kv.ScanOptions) (keys, size int64, err error) {
+	s.mu.Lock()
+	keydir := s.keydir.Clone()
+	s.mu.Unlock()
+
+	var (
+		now          = time.Now()
+		lower, upper = opts.Bounds()
+	)
+	keydir.AscendGreaterOrEqual(item{key: string(lower)}, func(it item) bool {
+		if upper != nil && it.key >= string(upper) {
+			return false
+		}
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+		if !it.expired(now) {
+			keys++
+			size += int64(len(it.key)) + int64(it.size)
+		}
+		return true
+	})
+	return keys, size, err
+}
@@ -0,0 +1,730 @@
+// Package bitcask is a log-structured kv.Store in the spirit of Bitcask:
+// every write is appended to the active data file and an in-memory
+// keydir points at the latest value of every key. Merge rewrites the
+// live values into a new file with a hint file for fast startup and
+// removes the files it replaces.
+package bitcask
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/watch"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/btree"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMaxFileSize   = 64 << 20
+	defaultMergeInterval = time.Minute
+	defaultMergeRatio    = 0.5
+	btreeDegree          = 32
+
+	dataSuffix  = ".data"
+	hintSuffix  = ".hint"
+	mergeSuffix = ".merge"
+	tmpSuffix   = ".tmp"
+	fileMode    = 0o644
+	dirMode     = 0o755
+)
+
+type Config struct {
+	Dir string
+	// MaxFileSize is the size after which the active data file is closed
+	// and a new one is started.
+	MaxFileSize int64
+	// SyncWrites syncs every write to disk before acknowledging it.
+	SyncWrites bool
+	// MergeInterval is how often the share of dead bytes is checked.
+	MergeInterval time.Duration
+	// MergeRatio is the share of dead bytes in the data files which
+	// triggers a merge.
+	MergeRatio float64
+}
+
+type Dependencies struct {
+	Log *logrus.Logger
+}
+
+// item is the keydir entry of a key: the location of its latest value.
+type item struct {
+	key       string
+	fileID    uint32
+	offset    int64
+	size      uint32
+	version   uint64
+	expiresAt time.Time
+}
+
+func lessItem(a, b item) bool {
+	return a.key < b.key
+}
+
+func (it item) expired(now time.Time) bool {
+	return !it.expiresAt.IsZero() && !now.Before(it.expiresAt)
+}
+
+// entrySize is the space taken by the entry of the item in its file.
+func (it item) entrySize() int64 {
+	return int64(entryHeaderSize + len(it.key) + int(it.size))
+}
+
+type dataFile struct {
+	id uint32
+	r  *os.File
+	// w is the append handle of the active file, nil for closed files.
+	w    *os.File
+	size int64
+	// total is the size of all entries of the file, dead is the size of
+	// the entries which are overwritten, deleted or tombstones.
+	total int64
+	dead  int64
+	// obsolete files were replaced by a merge and are removed once no
+	// snapshot or transaction reads them.
+	obsolete bool
+}
+
+type Store struct {
+	cfg  Config
+	deps Dependencies
+	log  *logrus.Entry
+
+	mu     sync.RWMutex
+	keydir *btree.BTreeG[item]
+	// version is the last version assigned to a write.
+	version uint64
+	files   map[uint32]*dataFile
+	active  *dataFile
+	buf     []byte
+	// pins counts open snapshots and transactions.
+	pins int
+	hub  *watch.Hub
+	// err is set when a failed write could not be undone, the store
+	// refuses every write after it.
+	err error
+
+	// mergeMu serializes merges, closed is set under it once the store
+	// is closing.
+	mergeMu sync.Mutex
+	closed  bool
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func New(cfg Config, deps Dependencies) (kv.Store, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("bitcask needs a directory")
+	}
+	if cfg.MaxFileSize <= 0 {
+		cfg.MaxFileSize = defaultMaxFileSize
+	}
+	if cfg.MergeInterval <= 0 {
+		cfg.MergeInterval = defaultMergeInterval
+	}
+	if cfg.MergeRatio <= 0 {
+		cfg.MergeRatio = defaultMergeRatio
+	}
+
+	s := &Store{
+		cfg:    cfg,
+		deps:   deps,
+		log:    deps.Log.WithField("component", "bitcask"),
+		keydir: btree.NewG(btreeDegree, lessItem),
+		files:  make(map[uint32]*dataFile),
+		hub:    watch.NewHub(),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if err := s.open(); err != nil {
+		s.closeFiles()
+		return nil, fmt.Errorf("open bitcask at %s: %w", cfg.Dir, err)
+	}
+
+	go s.merger()
+	return s, nil
+}
+
+func filePath(dir string, id uint32, suffix string) string {
+	return filepath.Join(dir, fmt.Sprintf("%010d%s", id, suffix))
+}
+
+// open rebuilds the keydir from the data files, using hint files where
+// merge wrote them.
+func (s *Store) open() error {
+	if err := os.MkdirAll(s.cfg.Dir, dirMode); err != nil {
+		return err
+	}
+
+	files, err := os.ReadDir(s.cfg.Dir)
+	if err != nil {
+		return err
+	}
+
+	var (
+		ids   []uint32
+		hints = make(map[uint32]bool)
+	)
+	for _, f := range files {
+		name := f.Name()
+		switch {
+		case strings.HasSuffix(name, mergeSuffix), strings.HasSuffix(name, tmpSuffix):
+			// Leftovers of an interrupted merge, the files it would
+			// replace are all still there.
+			os.Remove(filepath.Join(s.cfg.Dir, name))
+		case strings.HasSuffix(name, dataSuffix):
+			if id, err := strconv.ParseUint(strings.TrimSuffix(name, dataSuffix), 10, 32); err == nil {
+				ids = append(ids, uint32(id))
+			}
+		case strings.HasSuffix(name, hintSuffix):
+			if id, err := strconv.ParseUint(strings.TrimSuffix(name, hintSuffix), 10, 32); err == nil {
+				hints[uint32(id)] = true
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for id := range hints {
+		if _, err := os.Stat(filePath(s.cfg.Dir, id, dataSuffix)); errors.Is(err, os.ErrNotExist) {
+			// The merge stopped between moving the hint and the data.
+			os.Remove(filePath(s.cfg.Dir, id, hintSuffix))
+			delete(hints, id)
+		}
+	}
+
+	for i, id := range ids {
+		f, err := s.openFile(id, false)
+		if err != nil {
+			return err
+		}
+
+		if hints[id] {
+			err := s.loadHint(f)
+			if err == nil {
+				continue
+			}
+			s.log.WithError(err).WithField("file", id).Warn("reading data file instead of its hint")
+		}
+		if err := s.loadData(f, i == len(ids)-1); err != nil {
+			return err
+		}
+	}
+
+	// Files written by merge are never appended to, their hint would
+	// miss the new entries.
+	if n := len(ids); n > 0 && !hints[ids[n-1]] && s.files[ids[n-1]].size < s.cfg.MaxFileSize {
+		f := s.files[ids[n-1]]
+		f.w, err = os.OpenFile(filePath(s.cfg.Dir, f.id, dataSuffix), os.O_WRONLY|os.O_APPEND, fileMode)
+		if err != nil {
+			return err
+		}
+		s.active = f
+		return nil
+	}
+
+	var next uint32 = 1
+	if n := len(ids); n > 0 {
+		next = ids[n-1] + 1
+	}
+	s.active, err = s.openFile(next, true)
+	return err
+}
+
+func (s *Store) openFile(id uint32, writable bool) (*dataFile, error) {
+	path := filePath(s.cfg.Dir, id, dataSuffix)
+	f := &dataFile{id: id}
+
+	var err error
+	if writable {
+		if f.w, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, fileMode); err != nil {
+			return nil, err
+		}
+	}
+	if f.r, err = os.Open(path); err != nil {
+		if f.w != nil {
+			f.w.Close()
+		}
+		return nil, err
+	}
+
+	info, err := f.r.Stat()
+	if err != nil {
+		f.close()
+		return nil, err
+	}
+	f.size = info.Size()
+
+	s.files[id] = f
+	return f, nil
+}
+
+func (f *dataFile) close() error {
+	var err error
+	if f.w != nil {
+		if err = f.w.Sync(); err == nil {
+			err = f.w.Close()
+		} else {
+			f.w.Close()
+		}
+		f.w = nil
+	}
+	if cerr := f.r.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// loadData replays the records of the file. A torn record is expected
+// at the end of the last file when the process crashed in the middle of
+// a write, it is cut off so new records follow the last complete one.
+func (s *Store) loadData(f *dataFile, last bool) error {
+	r := bufio.NewReader(io.NewSectionReader(f.r, 0, f.size))
+
+	var offset int64
+	for {
+		version, entries, offsets, size, err := readRecord(r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(err, errTornRecord) && last {
+			s.log.WithField("file", f.id).WithField("offset", offset).
+				Warn("dropping torn record at the end of the data file")
+			f.size = offset
+			return os.Truncate(filePath(s.cfg.Dir, f.id, dataSuffix), offset)
+		}
+		if err != nil {
+			return fmt.Errorf("data file %d at offset %d: %w", f.id, offset, err)
+		}
+
+		for i, e := range entries {
+			s.applyEntry(f, offset+offsets[i], version, e)
+		}
+		s.version = max(s.version, version)
+		offset += size
+	}
+}
+
+func (s *Store) loadHint(f *dataFile) error {
+	buf, err := os.ReadFile(filePath(s.cfg.Dir, f.id, hintSuffix))
+	if err != nil {
+		return err
+	}
+
+	version, entries, err := decodeHint(buf)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		it := item{
+			key:       e.key,
+			fileID:    f.id,
+			offset:    e.offset,
+			size:      e.size,
+			version:   e.version,
+			expiresAt: e.expiresAt,
+		}
+		f.total += it.entrySize()
+		s.replace(it)
+	}
+	s.version = max(s.version, version)
+	return nil
+}
+
+// applyEntry points the keydir at an entry written at offset of the
+// file. It must be called with s.mu held.
+func (s *Store) applyEntry(f *dataFile, offset int64, version uint64, e recordEntry) {
+	f.total += e.size()
+	if e.tombstone {
+		f.dead += e.size()
+		if old, ok := s.keydir.Delete(item{key: string(e.key)}); ok {
+			s.markDead(old)
+		}
+		return
+	}
+
+	s.replace(item{
+		key:       string(e.key),
+		fileID:    f.id,
+		offset:    offset,
+		size:      uint32(len(e.value)),
+		version:   version,
+		expiresAt: e.expiresAt,
+	})
+}
+
+func (s *Store) replace(it item) {
+	if old, ok := s.keydir.ReplaceOrInsert(it); ok {
+		s.markDead(old)
+	}
+}
+
+func (s *Store) markDead(it item) {
+	if f, ok := s.files[it.fileID]; ok {
+		f.dead += it.entrySize()
+	}
+}
+
+// write appends the entries as a single record. It must be called with
+// s.mu held.
+func (s *Store) write(entries []recordEntry) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.active.size >= s.cfg.MaxFileSize {
+		if err := s.rotate(s.active.id + 1); err != nil {
+			return err
+		}
+	}
+
+	version := s.version + 1
+
+	var offsets []int64
+	s.buf, offsets = appendRecord(s.buf[:0], version, entries)
+	if _, err := s.active.w.Write(s.buf); err != nil {
+		// Drop a partially written record, so it does not hide the
+		// records appended after it.
+		return s.undo(err)
+	}
+	if s.cfg.SyncWrites {
+		if err := s.active.w.Sync(); err != nil {
+			// The write is not acknowledged, so it must not be
+			// recovered after a restart either.
+			return s.undo(err)
+		}
+	}
+
+	base := s.active.size
+	s.active.size += int64(len(s.buf))
+	s.version = version
+	for i, e := range entries {
+		s.applyEntry(s.active, base+offsets[i], version, e)
+	}
+	if s.hub.Watching() {
+		s.hub.Publish(entryEvents(version, entries)...)
+	}
+	return nil
+}
+
+// undo truncates the active file back to its last acknowledged record
+// after a failed write, the keydir offsets follow its size. When that
+// fails too the store is marked failed. It must be called with s.mu
+// held.
+func (s *Store) undo(err error) error {
+	if terr := s.active.w.Truncate(s.active.size); terr != nil {
+		s.err = fmt.Errorf("%w: %w", errFailed, errors.Join(err, terr))
+		return s.err
+	}
+	return err
+}
+
+// rotate closes the active file for writes and starts the file id. It
+// must be called with s.mu held.
+func (s *Store) rotate(id uint32) error {
+	next, err := s.openFile(id, true)
+	if err != nil {
+		return err
+	}
+
+	prev := s.active
+	s.active = next
+	if err := prev.w.Sync(); err != nil {
+		return err
+	}
+	err = prev.w.Close()
+	prev.w = nil
+	return err
+}
+
+// readValueLocked is readValue for the callers which do not hold s.mu.
+func (s *Store) readValueLocked(it item) (kv.Value, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readValue(it)
+}
+
+// readValue reads the value of the item. It must be called with s.mu
+// held for reading.
+func (s *Store) readValue(it item) (kv.Value, error) {
+	f, ok := s.files[it.fileID]
+	if !ok {
+		return nil, fmt.Errorf("bitcask: data file %d is missing", it.fileID)
+	}
+
+	value := make(kv.Value, it.size)
+	if _, err := f.r.ReadAt(value, it.offset); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (s *Store) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	return s.SetWithTTL(ctx, k, v, 0)
+}
+
+func (s *Store) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e := newEntry(k, v, ttl, time.Now())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write([]recordEntry{e})
+}
+
+func (s *Store) SetIfVersion(ctx context.Context, k kv.Key, v kv.Value, version uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	e := newEntry(k, v, 0, now)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cur, ok := s.lookup(string(k), now); !ok || cur.version != version {
+		return kv.ErrConflict
+	}
+	return s.write([]recordEntry{e})
+}
+
+func (s *Store) SetIfAbsent(ctx context.Context, k kv.Key, v kv.Value) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	e := newEntry(k, v, 0, now)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.lookup(string(k), now); ok {
+		return kv.ErrConflict
+	}
+	return s.write([]recordEntry{e})
+}
+
+func (s *Store) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	v, _, err := s.GetWithVersion(ctx, k)
+	return v, err
+}
+
+func (s *Store) GetWithVersion(ctx context.Context, k kv.Key) (kv.Value, uint64, error) {
+	v, version, _, err := s.GetWithExpiry(ctx, k)
+	return v, version, err
+}
+
+func (s *Store) GetWithExpiry(ctx context.Context, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	it, ok := s.lookup(string(k), time.Now())
+	if !ok {
+		return nil, 0, time.Time{}, kv.ErrNotFound
+	}
+
+	v, err := s.readValue(it)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	return v, it.version, it.expiresAt, nil
+}
+
+func (s *Store) Delete(ctx context.Context, k kv.Key) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.delete(k)
+}
+
+func (s *Store) DeleteIfVersion(ctx context.Context, k kv.Key, version uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cur, ok := s.lookup(string(k), time.Now()); !ok || cur.version != version {
+		return kv.ErrConflict
+	}
+	return s.delete(k)
+}
+
+// delete writes a tombstone if the key exists. It must be called with
+// s.mu held.
+func (s *Store) delete(k kv.Key) error {
+	if _, ok := s.keydir.Get(item{key: string(k)}); !ok {
+		return nil
+	}
+	return s.write([]recordEntry{{key: k, tombstone: true}})
+}
+
+func (s *Store) Write(ctx context.Context, ops []kv.Op) error {
+	if err := kv.ValidateOps(ops); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	entries := opsEntries(ops, time.Now())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(entries)
+}
+
+func opsEntries(ops []kv.Op, now time.Time) []recordEntry {
+	entries := make([]recordEntry, 0, len(ops))
+	for _, op := range ops {
+		switch op.Type {
+		case kv.OpSet:
+			entries = append(entries, newEntry(op.Key, op.Value, op.TTL, now))
+		case kv.OpDelete:
+			entries = append(entries, recordEntry{key: op.Key, tombstone: true})
+		}
+	}
+	return entries
+}
+
+// Scan iterates a snapshot, so no lock is held while f runs and f may
+// write to the store.
+func (s *Store) Scan(ctx context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
+	snap, err := s.Snapshot(ctx)
+	if err != nil {
+		return err
+	}
+	defer snap.Close()
+
+	return snap.Scan(ctx, opts, f)
+}
+
+// scanKeydir iterates live items of the keydir in the range of the scan,
+// items expired at now are skipped. It stops with ctx.Err() once ctx is
+// done.
+func scanKeydir(ctx context.Context, keydir *btree.BTreeG[item], opts kv.ScanOptions, now time.Time,
+	read func(item) (kv.Value, error), f kv.ScanHandler) error {
+	limit := -1
+	if opts.Limit != 0 {
+		limit = opts.Limit
+	}
+
+	var (
+		lower, upper = opts.Bounds()
+		err          error
+	)
+	iter := func(it item) bool {
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+		if it.expired(now) {
+			return true
+		}
+
+		var v kv.Value
+		if v, err = read(it); err != nil {
+			return false
+		}
+
+		limit--
+		if err = f(kv.Key(it.key), v); err != nil {
+			return false
+		}
+		return limit != 0
+	}
+
+	switch {
+	case opts.Reverse:
+		pivot := item{key: string(upper)}
+		descend := func(it item) bool {
+			if it.key < string(lower) {
+				return false
+			}
+			if upper != nil && it.key == pivot.key {
+				return true
+			}
+			return iter(it)
+		}
+		if upper == nil {
+			keydir.Descend(descend)
+		} else {
+			keydir.DescendLessOrEqual(pivot, descend)
+		}
+	case upper == nil:
+		keydir.AscendGreaterOrEqual(item{key: string(lower)}, iter)
+	default:
+		keydir.AscendRange(item{key: string(lower)}, item{key: string(upper)}, iter)
+	}
+	if err != nil && !errors.Is(err, kv.ErrStopScan) {
+		return err
+	}
+	return nil
+}
+
+func (s *Store) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+		s.hub.Close()
+
+		// A running Compact finishes first.
+		s.mergeMu.Lock()
+		s.closed = true
+		s.mergeMu.Unlock()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		err = s.closeFiles()
+	})
+	return err
+}
+
+func (s *Store) closeFiles() error {
+	var err error
+	for _, f := range s.files {
+		if cerr := f.close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func newEntry(k kv.Key, v kv.Value, ttl time.Duration, now time.Time) recordEntry {
+	e := recordEntry{key: k, value: v}
+	if ttl > 0 {
+		e.expiresAt = now.Add(ttl)
+	}
+	return e
+}
+
+// lookup returns a live item for the key. It must be called with s.mu
+// held.
+func (s *Store) lookup(key string, now time.Time) (item, bool) {
+	it, ok := s.keydir.Get(item{key: key})
+	if !ok || it.expired(now) {
+		return item{}, false
+	}
+	return it, true
+}
@@ -0,0 +1,290 @@
+package bitcask
+
+import (
+	"context"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvtests"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func openStore(tb testing.TB, dir string) *Store {
+	tb.Helper()
+	s, err := New(Config{
+		Dir:           dir,
+		MaxFileSize:   1024,
+		MergeInterval: time.Hour,
+	}, Dependencies{Log: logrus.StandardLogger()})
+	require.NoError(tb, err)
+	return s.(*Store)
+}
+
+func newTestStore(tb testing.TB) kv.Store {
+	return openStore(tb, tb.TempDir())
+}
+
+// crash stops the store the way a killed process would.
+func crash(s *Store) {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+		for _, f := range s.files {
+			if f.w != nil {
+				f.w.Close()
+			}
+			f.r.Close()
+		}
+	})
+}
+
+func requireValue(t *testing.T, s kv.Store, key, want string) {
+	t.Helper()
+	val, err := s.Get(context.Background(), kv.Key(key))
+	require.NoError(t, err, key)
+	require.Equal(t, kv.Value(want), val, key)
+}
+
+func requireNotFound(t *testing.T, s kv.Store, key string) {
+	t.Helper()
+	_, err := s.Get(context.Background(), kv.Key(key))
+	require.ErrorIs(t, err, kv.ErrNotFound, key)
+}
+
+func dataFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*"+dataSuffix))
+	require.NoError(t, err)
+	return files
+}
+
+func TestBitcask(t *testing.T) {
+	kvtests.RunTests(t, newTestStore)
+}
+
+func FuzzBitcask(f *testing.F) {
+	kvtests.Fuzz(f, newTestStore)
+}
+
+func TestRecovery(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openStore(t, dir)
+	)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, s.Set(ctx, kv.Key(fmt.Sprintf("key%03d", i)), kv.Value(fmt.Sprintf("v%d", i))))
+	}
+	require.NoError(t, s.Delete(ctx, kv.Key("key000")))
+	require.NoError(t, s.SetWithTTL(ctx, kv.Key("ttl"), kv.Value("t"), time.Hour))
+	require.NoError(t, s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("key001"), Value: kv.Value("batch")},
+		{Type: kv.OpDelete, Key: kv.Key("key002")},
+	}))
+	_, version, err := s.GetWithVersion(ctx, kv.Key("key001"))
+	require.NoError(t, err)
+	require.Greater(t, len(dataFiles(t, dir)), 1)
+	crash(s)
+
+	s = openStore(t, dir)
+	defer s.Close()
+	requireNotFound(t, s, "key000")
+	requireValue(t, s, "key001", "batch")
+	requireNotFound(t, s, "key002")
+	requireValue(t, s, "key099", "v99")
+	requireValue(t, s, "ttl", "t")
+
+	require.NoError(t, s.Set(ctx, kv.Key("key001"), kv.Value("new")))
+	_, newVersion, err := s.GetWithVersion(ctx, kv.Key("key001"))
+	require.NoError(t, err)
+	require.Greater(t, newVersion, version)
+}
+
+func TestTornRecord(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openStore(t, dir)
+	)
+
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("1")))
+	require.NoError(t, s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("b"), Value: kv.Value("2")},
+		{Type: kv.OpSet, Key: kv.Key("c"), Value: kv.Value("3")},
+	}))
+	path := filePath(dir, s.active.id, dataSuffix)
+	crash(s)
+
+	// Cut the batch in the middle, none of it is applied.
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-5))
+
+	s = openStore(t, dir)
+	requireValue(t, s, "a", "1")
+	requireNotFound(t, s, "b")
+	requireNotFound(t, s, "c")
+
+	// New records follow the last complete one.
+	require.NoError(t, s.Set(ctx, kv.Key("d"), kv.Value("4")))
+	crash(s)
+
+	s = openStore(t, dir)
+	defer s.Close()
+	requireValue(t, s, "a", "1")
+	requireValue(t, s, "d", "4")
+}
+
+func TestMerge(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openStore(t, dir)
+	)
+
+	for round := 0; round < 10; round++ {
+		for i := 0; i < 20; i++ {
+			key := kv.Key(fmt.Sprintf("key%02d", i))
+			require.NoError(t, s.Set(ctx, key, kv.Value(fmt.Sprintf("v%d-%d", i, round))))
+		}
+	}
+	require.NoError(t, s.Delete(ctx, kv.Key("key00")))
+	require.NoError(t, s.SetWithTTL(ctx, kv.Key("expiring"), kv.Value("x"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	before := len(dataFiles(t, dir))
+	require.True(t, s.needsMerge())
+	require.NoError(t, s.merge())
+	require.False(t, s.needsMerge())
+
+	// The merged file and the new active one are left.
+	require.Less(t, len(dataFiles(t, dir)), before)
+	require.Len(t, dataFiles(t, dir), 2)
+
+	requireNotFound(t, s, "key00")
+	requireValue(t, s, "key01", "v1-9")
+	requireNotFound(t, s, "expiring")
+
+	// The merged file is loaded from its hint.
+	require.NoError(t, s.Set(ctx, kv.Key("key02"), kv.Value("after")))
+	require.NoError(t, s.Close())
+
+	s = openStore(t, dir)
+	defer s.Close()
+	requireNotFound(t, s, "key00")
+	requireValue(t, s, "key01", "v1-9")
+	requireValue(t, s, "key02", "after")
+	requireValue(t, s, "key19", "v19-9")
+}
+
+func TestMergeKeepsPinnedFiles(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openStore(t, dir)
+	)
+	defer s.Close()
+
+	for i := 0; i < 50; i++ {
+		require.NoError(t, s.Set(ctx, kv.Key("key"), kv.Value(fmt.Sprintf("v%d", i))))
+	}
+
+	snap, err := s.Snapshot(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Set(ctx, kv.Key("key"), kv.Value("new")))
+	require.NoError(t, s.merge())
+
+	// The snapshot still reads the replaced files.
+	val, err := snap.Get(ctx, kv.Key("key"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("v49"), val)
+	before := len(dataFiles(t, dir))
+
+	require.NoError(t, snap.Close())
+	require.Less(t, len(dataFiles(t, dir)), before)
+	requireValue(t, s, "key", "new")
+}
+
+func TestConcurrentMerge(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		dir  = t.TempDir()
+		s    = openStore(t, dir)
+		done = make(chan struct{})
+	)
+	defer s.Close()
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 500; i++ {
+			key := kv.Key(fmt.Sprintf("key%d", i%10))
+			if err := s.Set(ctx, key, kv.Value(fmt.Sprintf("v%d", i))); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.merge())
+	}
+	<-done
+
+	for i := 0; i < 10; i++ {
+		requireValue(t, s, fmt.Sprintf("key%d", i), fmt.Sprintf("v%d", 490+i))
+	}
+}
+
+func TestCompact(t *testing.T) {
+	var (
+		ctx = context.Background()
+		s   = openStore(t, t.TempDir())
+	)
+
+	for round := 0; round < 10; round++ {
+		for i := 0; i < 20; i++ {
+			key := kv.Key(fmt.Sprintf("key%02d", i))
+			require.NoError(t, s.Set(ctx, key, kv.Value(fmt.Sprintf("v%d-%d", i, round))))
+		}
+	}
+
+	reclaimed, err := s.Compact(ctx)
+	require.NoError(t, err)
+	require.Positive(t, reclaimed)
+	requireValue(t, s, "key01", "v1-9")
+
+	require.NoError(t, s.Close())
+	_, err = s.Compact(ctx)
+	require.ErrorIs(t, err, kv.ErrClosed)
+}
+
+func TestFailedWrite(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openStore(t, dir)
+	)
+
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("1")))
+
+	// Neither the write nor the truncation can succeed on a closed
+	// file, so the store refuses every later write.
+	require.NoError(t, s.active.w.Close())
+	require.ErrorIs(t, s.Set(ctx, kv.Key("b"), kv.Value("2")), errFailed)
+	require.ErrorIs(t, s.Set(ctx, kv.Key("c"), kv.Value("3")), errFailed)
+	requireValue(t, s, "a", "1")
+	crash(s)
+
+	s = openStore(t, dir)
+	defer s.Close()
+	requireValue(t, s, "a", "1")
+	requireNotFound(t, s, "b")
+	requireNotFound(t, s, "c")
+}
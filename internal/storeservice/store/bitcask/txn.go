@@ -0,0 +1,154 @@
+package bitcask
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// txn reads from a copy-on-write clone of the keydir taken at Begin and
+// validates at Commit that every key it has read still has the version
+// observed. The transaction pins the data files until it finishes.
+type txn struct {
+	s      *Store
+	keydir *btree.BTreeG[item]
+
+	// reads holds versions of the keys read from the clone, zero means
+	// the key did not exist.
+	reads  map[string]uint64
+	writes map[string]kv.Op
+	closed bool
+}
+
+func (s *Store) Begin(ctx context.Context) (kv.Txn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Clone modifies the original tree, so it needs the exclusive lock.
+	s.mu.Lock()
+	keydir := s.keydir.Clone()
+	s.pins++
+	s.mu.Unlock()
+
+	return &txn{
+		s:      s,
+		keydir: keydir,
+		reads:  make(map[string]uint64),
+		writes: make(map[string]kv.Op),
+	}, nil
+}
+
+func (t *txn) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	if t.closed {
+		return nil, kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	key := string(k)
+	if op, ok := t.writes[key]; ok {
+		if op.Type == kv.OpDelete {
+			return nil, kv.ErrNotFound
+		}
+		return op.Value, nil
+	}
+
+	it, ok := t.keydir.Get(item{key: key})
+	if !ok || it.expired(time.Now()) {
+		if _, seen := t.reads[key]; !seen {
+			t.reads[key] = 0
+		}
+		return nil, kv.ErrNotFound
+	}
+
+	v, err := t.s.readValueLocked(it)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, seen := t.reads[key]; !seen {
+		t.reads[key] = it.version
+	}
+	return v, nil
+}
+
+func (t *txn) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	return t.SetWithTTL(ctx, k, v, 0)
+}
+
+func (t *txn) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	t.writes[string(k)] = kv.Op{Type: kv.OpSet, Key: k, Value: v, TTL: ttl}
+	return nil
+}
+
+func (t *txn) Delete(ctx context.Context, k kv.Key) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	t.writes[string(k)] = kv.Op{Type: kv.OpDelete, Key: k}
+	return nil
+}
+
+func (t *txn) Commit(ctx context.Context) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	t.close()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if len(t.writes) == 0 {
+		return nil
+	}
+
+	ops := make([]kv.Op, 0, len(t.writes))
+	for _, op := range t.writes {
+		ops = append(ops, op)
+	}
+
+	now := time.Now()
+	entries := opsEntries(ops, now)
+
+	t.s.mu.Lock()
+	defer t.s.mu.Unlock()
+	for key, version := range t.reads {
+		cur, ok := t.s.lookup(key, now)
+		if !ok {
+			cur.version = 0
+		}
+		if cur.version != version {
+			return kv.ErrConflict
+		}
+	}
+
+	return t.s.write(entries)
+}
+
+func (t *txn) Rollback() error {
+	if !t.closed {
+		t.close()
+	}
+	return nil
+}
+
+func (t *txn) close() {
+	t.closed = true
+	t.keydir = nil
+	t.s.unpin()
+}
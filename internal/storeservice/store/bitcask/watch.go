@@ -0,0 +1,74 @@
+package bitcask
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"strings"
+	"time"
+)
+
+// Watch gets the events from write, which publishes them with s.mu held,
+// so all events are in version order.
+func (s *Store) Watch(ctx context.Context, prefix kv.Key, fromVersion uint64, f kv.WatchHandler) error {
+	return s.hub.Watch(ctx, prefix, fromVersion, s.catchUp, f)
+}
+
+// catchUp reads a pinned clone of the keydir, so writers are not blocked
+// while the events are handled.
+func (s *Store) catchUp(ctx context.Context, prefix kv.Key, fromVersion uint64, emit kv.WatchHandler) (uint64, error) {
+	s.mu.Lock()
+	keydir, version := s.keydir.Clone(), s.version
+	s.pins++
+	s.mu.Unlock()
+	defer s.unpin()
+
+	var (
+		now = time.Now()
+		err error
+	)
+	keydir.AscendGreaterOrEqual(item{key: string(prefix)}, func(it item) bool {
+		if !strings.HasPrefix(it.key, string(prefix)) {
+			return false
+		}
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+		if it.version <= fromVersion || it.expired(now) {
+			return true
+		}
+
+		var value kv.Value
+		if value, err = s.readValueLocked(it); err != nil {
+			return false
+		}
+
+		err = emit(kv.Event{
+			Type:    kv.EventPut,
+			Key:     kv.Key(it.key),
+			Value:   value,
+			Version: it.version,
+		})
+		return err == nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func entryEvents(version uint64, entries []recordEntry) []kv.Event {
+	events := make([]kv.Event, len(entries))
+	for i, e := range entries {
+		events[i] = kv.Event{
+			Type:    kv.EventPut,
+			Key:     e.key,
+			Value:   e.value,
+			Version: version,
+		}
+		if e.tombstone {
+			events[i].Type = kv.EventDelete
+			events[i].Value = nil
+		}
+	}
+	return events
+}
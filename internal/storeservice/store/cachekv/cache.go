@@ -0,0 +1,332 @@
+// Package cachekv wraps a kv.Store with a bounded in-process read cache.
+package cachekv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/maphash"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const defaultShards = 16
+
+type Config struct {
+	// MaxBytes bounds the estimated memory of the cached entries, the
+	// least recently used ones are evicted beyond it. Zero disables the
+	// cache.
+	MaxBytes int64
+	// TTL is how long an entry is served from the cache, zero keeps it
+	// until it is evicted or invalidated. An entry is never served past
+	// the expiry of its key when the wrapped store is a kv.Expirer,
+	// otherwise an expired key may be served until TTL passes.
+	TTL time.Duration
+	// NegativeTTL caches the keys which were not found for that long,
+	// zero does not cache them.
+	NegativeTTL time.Duration
+	// Shards is the number of independently locked parts of the cache,
+	// each gets an equal part of MaxBytes.
+	Shards int
+}
+
+// Enabled reports whether the configuration asks for a cache.
+func (c Config) Enabled() bool {
+	return c.MaxBytes > 0
+}
+
+type Dependencies struct {
+	// Store is the wrapped store, it is closed with the cache.
+	Store kv.Store
+	// Registry gets the cache metrics, nil leaves them out.
+	Registry *prometheus.Registry
+}
+
+// Store serves Get and GetWithVersion from the cache and passes every
+// other call to the wrapped store. Writes made through it invalidate
+// the keys they touch once the wrapped store applied them, so a read
+// which starts after a write returns never sees the old value. Writes
+// bypassing it are not seen until the entries expire.
+type Store struct {
+	cfg  Config
+	deps Dependencies
+
+	shards  []*shard
+	seed    maphash.Seed
+	metrics *metrics
+}
+
+func New(cfg Config, deps Dependencies) (*Store, error) {
+	if cfg.MaxBytes <= 0 {
+		return nil, fmt.Errorf("cache size must be positive, got %d", cfg.MaxBytes)
+	}
+	if cfg.TTL < 0 || cfg.NegativeTTL < 0 {
+		return nil, fmt.Errorf("negative cache ttl: %s, %s", cfg.TTL, cfg.NegativeTTL)
+	}
+	if cfg.Shards <= 0 {
+		cfg.Shards = defaultShards
+	}
+
+	s := &Store{
+		cfg:  cfg,
+		deps: deps,
+		seed: maphash.MakeSeed(),
+	}
+	s.shards = make([]*shard, cfg.Shards)
+	for i := range s.shards {
+		s.shards[i] = newShard(cfg.MaxBytes / int64(cfg.Shards))
+	}
+	s.metrics = newMetrics(s)
+	for _, sh := range s.shards {
+		sh.evictions = s.metrics.evictions
+	}
+
+	if deps.Registry != nil {
+		if err := deps.Registry.Register(s.metrics); err != nil {
+			return nil, fmt.Errorf("register cache metrics: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func (s *Store) shardOf(key string) *shard {
+	return s.shards[maphash.String(s.seed, key)%uint64(len(s.shards))]
+}
+
+func (s *Store) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	v, _, err := s.GetWithVersion(ctx, k)
+	return v, err
+}
+
+func (s *Store) GetWithVersion(ctx context.Context, k kv.Key) (kv.Value, uint64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	key := string(k)
+	sh := s.shardOf(key)
+	now := time.Now()
+
+	e, gen, ok := sh.get(key, now)
+	if ok {
+		if e.missing {
+			s.metrics.negativeHits.Inc()
+			return nil, 0, kv.ErrNotFound
+		}
+		s.metrics.hits.Inc()
+		return e.value, e.version, nil
+	}
+	s.metrics.misses.Inc()
+
+	v, version, keyExpiresAt, err := s.getWithExpiry(ctx, k)
+	switch {
+	case err == nil:
+		expiresAt := s.expiry(now, s.cfg.TTL)
+		if !keyExpiresAt.IsZero() && (expiresAt.IsZero() || keyExpiresAt.Before(expiresAt)) {
+			expiresAt = keyExpiresAt
+		}
+		sh.fill(gen, entry{key: key, value: v, version: version, expiresAt: expiresAt})
+	case errors.Is(err, kv.ErrNotFound) && s.cfg.NegativeTTL > 0:
+		sh.fill(gen, entry{key: key, missing: true, expiresAt: s.expiry(now, s.cfg.NegativeTTL)})
+	}
+	return v, version, err
+}
+
+// getWithExpiry reads the key from the wrapped store, the expiry is zero
+// when the store does not tell it.
+func (s *Store) getWithExpiry(ctx context.Context, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	if e, ok := s.deps.Store.(kv.Expirer); ok {
+		return e.GetWithExpiry(ctx, k)
+	}
+	v, version, err := s.deps.Store.GetWithVersion(ctx, k)
+	return v, version, time.Time{}, err
+}
+
+// GetWithExpiry reads the wrapped store if it is a kv.Expirer, the cache
+// does not keep the expiry of the keys.
+func (s *Store) GetWithExpiry(ctx context.Context, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	e, ok := s.deps.Store.(kv.Expirer)
+	if !ok {
+		return nil, 0, time.Time{}, kv.ErrExpiryUnsupported
+	}
+	return e.GetWithExpiry(ctx, k)
+}
+
+// expiry returns the time an entry filled at now stops being served,
+// zero for never.
+func (s *Store) expiry(now time.Time, ttl time.Duration) time.Time {
+	if ttl == 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// invalidate drops the keys from the cache. It is called after the
+// wrapped store applied a write, failed writes invalidate as well since
+// they may have been applied partially.
+func (s *Store) invalidate(keys ...kv.Key) {
+	for _, k := range keys {
+		s.shardOf(string(k)).invalidate(string(k))
+	}
+}
+
+// invalidateRange drops the keys in [start, end) from every shard, nil
+// end means no upper bound.
+func (s *Store) invalidateRange(start, end kv.Key) {
+	for _, sh := range s.shards {
+		sh.invalidateRange(string(start), string(end), end == nil)
+	}
+}
+
+func (s *Store) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	defer s.invalidate(k)
+	return s.deps.Store.Set(ctx, k, v)
+}
+
+func (s *Store) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	defer s.invalidate(k)
+	return s.deps.Store.SetWithTTL(ctx, k, v, ttl)
+}
+
+func (s *Store) Delete(ctx context.Context, k kv.Key) error {
+	defer s.invalidate(k)
+	return s.deps.Store.Delete(ctx, k)
+}
+
+func (s *Store) DeletePrefix(ctx context.Context, prefix kv.Key) (int64, error) {
+	lower, upper := kv.ScanOptions{Prefix: prefix}.Bounds()
+	defer s.invalidateRange(lower, upper)
+	return s.deps.Store.DeletePrefix(ctx, prefix)
+}
+
+func (s *Store) DeleteRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	if len(end) == 0 {
+		end = nil
+	}
+	defer s.invalidateRange(start, end)
+	return s.deps.Store.DeleteRange(ctx, start, end)
+}
+
+func (s *Store) SetIfVersion(ctx context.Context, k kv.Key, v kv.Value, version uint64) error {
+	defer s.invalidate(k)
+	return s.deps.Store.SetIfVersion(ctx, k, v, version)
+}
+
+func (s *Store) SetIfAbsent(ctx context.Context, k kv.Key, v kv.Value) error {
+	defer s.invalidate(k)
+	return s.deps.Store.SetIfAbsent(ctx, k, v)
+}
+
+func (s *Store) DeleteIfVersion(ctx context.Context, k kv.Key, version uint64) error {
+	defer s.invalidate(k)
+	return s.deps.Store.DeleteIfVersion(ctx, k, version)
+}
+
+func (s *Store) Write(ctx context.Context, ops []kv.Op) error {
+	defer func() {
+		for _, op := range ops {
+			s.invalidate(op.Key)
+		}
+	}()
+	return s.deps.Store.Write(ctx, ops)
+}
+
+func (s *Store) Scan(ctx context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
+	return s.deps.Store.Scan(ctx, opts, f)
+}
+
+func (s *Store) Begin(ctx context.Context) (kv.Txn, error) {
+	t, err := s.deps.Store.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &txn{Txn: t, s: s}, nil
+}
+
+// Snapshot reads the wrapped store, the cache holds the latest values
+// only.
+func (s *Store) Snapshot(ctx context.Context) (kv.Snapshot, error) {
+	return s.deps.Store.Snapshot(ctx)
+}
+
+func (s *Store) Watch(ctx context.Context, prefix kv.Key, fromVersion uint64, f kv.WatchHandler) error {
+	return s.deps.Store.Watch(ctx, prefix, fromVersion, f)
+}
+
+func (s *Store) Count(ctx context.Context, prefix kv.Key) (int64, error) {
+	return s.deps.Store.Count(ctx, prefix)
+}
+
+func (s *Store) CountRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	return s.deps.Store.CountRange(ctx, start, end)
+}
+
+func (s *Store) Stats(ctx context.Context) (kv.Stats, error) {
+	return s.deps.Store.Stats(ctx)
+}
+
+func (s *Store) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	return s.deps.Store.Backup(ctx, w, since)
+}
+
+func (s *Store) Restore(ctx context.Context, r io.Reader) error {
+	defer s.invalidateRange(nil, nil)
+	return s.deps.Store.Restore(ctx, r)
+}
+
+// Compact compacts the wrapped store if it supports it.
+func (s *Store) Compact(ctx context.Context) (int64, error) {
+	c, ok := s.deps.Store.(kv.Compactor)
+	if !ok {
+		return 0, kv.ErrCompactUnsupported
+	}
+	return c.Compact(ctx)
+}
+
+func (s *Store) Close() error {
+	if s.deps.Registry != nil {
+		s.deps.Registry.Unregister(s.metrics)
+	}
+	return s.deps.Store.Close()
+}
+
+// txn invalidates the keys it wrote once committed. Its reads see the
+// state of the wrapped store at its start, they bypass the cache.
+type txn struct {
+	kv.Txn
+	s       *Store
+	written []kv.Key
+}
+
+func (t *txn) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	if err := t.Txn.Set(ctx, k, v); err != nil {
+		return err
+	}
+	t.written = append(t.written, bytes.Clone(k))
+	return nil
+}
+
+func (t *txn) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	if err := t.Txn.SetWithTTL(ctx, k, v, ttl); err != nil {
+		return err
+	}
+	t.written = append(t.written, bytes.Clone(k))
+	return nil
+}
+
+func (t *txn) Delete(ctx context.Context, k kv.Key) error {
+	if err := t.Txn.Delete(ctx, k); err != nil {
+		return err
+	}
+	t.written = append(t.written, bytes.Clone(k))
+	return nil
+}
+
+func (t *txn) Commit(ctx context.Context) error {
+	defer t.s.invalidate(t.written...)
+	return t.Txn.Commit(ctx)
+}
@@ -0,0 +1,312 @@
+package cachekv
+
+import (
+	"context"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvtests"
+	"kvstore/internal/storeservice/store/mapkv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// countingStore counts the reads which reach the wrapped store.
+type countingStore struct {
+	kv.Store
+	reads atomic.Int64
+}
+
+func (s *countingStore) GetWithVersion(ctx context.Context, k kv.Key) (kv.Value, uint64, error) {
+	s.reads.Add(1)
+	return s.Store.GetWithVersion(ctx, k)
+}
+
+func (s *countingStore) GetWithExpiry(ctx context.Context, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	s.reads.Add(1)
+	return s.Store.(kv.Expirer).GetWithExpiry(ctx, k)
+}
+
+func newCached(tb testing.TB, cfg Config, reg *prometheus.Registry) (*Store, *countingStore) {
+	tb.Helper()
+	inner := &countingStore{Store: mapkv.NewStore()}
+	s, err := New(cfg, Dependencies{Store: inner, Registry: reg})
+	require.NoError(tb, err)
+	return s, inner
+}
+
+func TestCacheKV(t *testing.T) {
+	kvtests.RunTests(t, func(tb testing.TB) kv.Store {
+		s, _ := newCached(tb, Config{MaxBytes: 1 << 20, TTL: 500 * time.Millisecond, NegativeTTL: time.Minute}, nil)
+		return s
+	})
+}
+
+func FuzzCacheKV(f *testing.F) {
+	kvtests.Fuzz(f, func(tb testing.TB) kv.Store {
+		s, _ := newCached(tb, Config{MaxBytes: 1 << 20}, nil)
+		return s
+	})
+}
+
+func TestCacheHit(t *testing.T) {
+	ctx := context.Background()
+	s, inner := newCached(t, Config{MaxBytes: 1 << 20}, nil)
+	defer s.Close()
+
+	requireGet := func(key, value string, reads int64) {
+		t.Helper()
+		v, err := s.Get(ctx, kv.Key(key))
+		require.NoError(t, err)
+		require.Equal(t, kv.Value(value), v)
+		require.Equal(t, reads, inner.reads.Load())
+	}
+
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("1")))
+	requireGet("a", "1", 1)
+	requireGet("a", "1", 1)
+
+	_, version, err := s.GetWithVersion(ctx, kv.Key("a"))
+	require.NoError(t, err)
+	require.NoError(t, s.SetIfVersion(ctx, kv.Key("a"), kv.Value("2"), version))
+	requireGet("a", "2", 2)
+
+	require.NoError(t, s.Write(ctx, []kv.Op{{Type: kv.OpSet, Key: kv.Key("a"), Value: kv.Value("3")}}))
+	requireGet("a", "3", 3)
+
+	txn, err := s.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, txn.Set(ctx, kv.Key("a"), kv.Value("4")))
+	requireGet("a", "3", 3)
+	require.NoError(t, txn.Commit(ctx))
+	requireGet("a", "4", 4)
+
+	_, err = s.DeletePrefix(ctx, kv.Key("a"))
+	require.NoError(t, err)
+	_, err = s.Get(ctx, kv.Key("a"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+
+	// Missing keys are not cached without a negative TTL.
+	_, err = s.Get(ctx, kv.Key("a"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+	require.Equal(t, int64(6), inner.reads.Load())
+}
+
+func TestCacheNegative(t *testing.T) {
+	ctx := context.Background()
+	s, inner := newCached(t, Config{MaxBytes: 1 << 20, NegativeTTL: 50 * time.Millisecond}, nil)
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := s.Get(ctx, kv.Key("missing"))
+		require.ErrorIs(t, err, kv.ErrNotFound)
+	}
+	require.Equal(t, int64(1), inner.reads.Load())
+
+	time.Sleep(60 * time.Millisecond)
+	_, err := s.Get(ctx, kv.Key("missing"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+	require.Equal(t, int64(2), inner.reads.Load())
+
+	// A write replaces the cached miss.
+	require.NoError(t, s.SetIfAbsent(ctx, kv.Key("missing"), kv.Value("found")))
+	v, err := s.Get(ctx, kv.Key("missing"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("found"), v)
+}
+
+func TestCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	s, inner := newCached(t, Config{MaxBytes: 1 << 20, TTL: 50 * time.Millisecond}, nil)
+	defer s.Close()
+
+	require.NoError(t, s.Set(ctx, kv.Key("key"), kv.Value("old")))
+	_, err := s.Get(ctx, kv.Key("key"))
+	require.NoError(t, err)
+
+	// A write bypassing the cache is seen once the entry expires.
+	require.NoError(t, inner.Set(ctx, kv.Key("key"), kv.Value("new")))
+	v, err := s.Get(ctx, kv.Key("key"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("old"), v)
+
+	time.Sleep(60 * time.Millisecond)
+	v, err = s.Get(ctx, kv.Key("key"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("new"), v)
+}
+
+// TestCacheKeyTTL checks that a key is not served past its own expiry
+// although the cache would keep it for longer.
+func TestCacheKeyTTL(t *testing.T) {
+	ctx := context.Background()
+	s, inner := newCached(t, Config{MaxBytes: 1 << 20}, nil)
+	defer s.Close()
+
+	require.NoError(t, s.SetWithTTL(ctx, kv.Key("key"), kv.Value("val"), 50*time.Millisecond))
+	for i := 0; i < 3; i++ {
+		v, err := s.Get(ctx, kv.Key("key"))
+		require.NoError(t, err)
+		require.Equal(t, kv.Value("val"), v)
+	}
+	require.Equal(t, int64(1), inner.reads.Load())
+
+	time.Sleep(60 * time.Millisecond)
+	_, err := s.Get(ctx, kv.Key("key"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+	require.Equal(t, int64(2), inner.reads.Load())
+}
+
+func TestCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	size := (&entry{key: "k0", value: kv.Value("value")}).size()
+	s, inner := newCached(t, Config{MaxBytes: 3 * size, Shards: 1}, nil)
+	defer s.Close()
+
+	get := func(keys ...string) {
+		for _, key := range keys {
+			_, err := s.Get(ctx, kv.Key(key))
+			require.NoError(t, err)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		require.NoError(t, s.Set(ctx, kv.Key(fmt.Sprintf("k%d", i)), kv.Value("value")))
+	}
+
+	get("k0", "k1", "k2", "k0", "k3")
+	require.Equal(t, int64(4), inner.reads.Load())
+	require.Equal(t, float64(1), testutil.ToFloat64(s.metrics.evictions))
+
+	// k1 was the least recently used.
+	get("k0", "k2", "k3")
+	require.Equal(t, int64(4), inner.reads.Load())
+	get("k1")
+	require.Equal(t, int64(5), inner.reads.Load())
+
+	// An entry larger than the cache is not cached.
+	require.NoError(t, s.Set(ctx, kv.Key("big"), make(kv.Value, 3*size)))
+	get("big", "big")
+	require.Equal(t, int64(7), inner.reads.Load())
+	require.LessOrEqual(t, testutil.ToFloat64(s.metrics.bytes), float64(3*size))
+}
+
+// TestCacheConcurrent checks that a read racing with a write never
+// leaves the old value in the cache.
+func TestCacheConcurrent(t *testing.T) {
+	const (
+		writers = 4
+		readers = 4
+		keys    = 16
+		writes  = 2000
+	)
+	ctx := context.Background()
+	s, _ := newCached(t, Config{MaxBytes: 1 << 20, NegativeTTL: time.Minute}, nil)
+	defer s.Close()
+
+	var (
+		wg   sync.WaitGroup
+		stop = make(chan struct{})
+		last [writers][keys]int
+	)
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				_, _ = s.Get(ctx, kv.Key(fmt.Sprintf("w%d/%d", i%writers, i%keys)))
+			}
+		}()
+	}
+
+	var writersWG sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		writersWG.Add(1)
+		go func(w int) {
+			defer writersWG.Done()
+			for i := 0; i < writes; i++ {
+				k := i % keys
+				key := kv.Key(fmt.Sprintf("w%d/%d", w, k))
+				var err error
+				if i%7 == 0 {
+					err = s.Delete(ctx, key)
+					last[w][k] = -1
+				} else {
+					err = s.Set(ctx, key, kv.Value(fmt.Sprint(i)))
+					last[w][k] = i
+				}
+				if err != nil {
+					panic(err)
+				}
+			}
+		}(w)
+	}
+	writersWG.Wait()
+	close(stop)
+	wg.Wait()
+
+	for w := 0; w < writers; w++ {
+		for k := 0; k < keys; k++ {
+			v, err := s.Get(ctx, kv.Key(fmt.Sprintf("w%d/%d", w, k)))
+			if last[w][k] < 0 {
+				require.ErrorIs(t, err, kv.ErrNotFound)
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, kv.Value(fmt.Sprint(last[w][k])), v)
+		}
+	}
+}
+
+func TestCacheMetrics(t *testing.T) {
+	ctx := context.Background()
+	reg := prometheus.NewRegistry()
+	s, _ := newCached(t, Config{MaxBytes: 1 << 20, NegativeTTL: time.Minute}, reg)
+
+	require.NoError(t, s.Set(ctx, kv.Key("key"), kv.Value("value")))
+	for i := 0; i < 3; i++ {
+		_, err := s.Get(ctx, kv.Key("key"))
+		require.NoError(t, err)
+		_, err = s.Get(ctx, kv.Key("missing"))
+		require.ErrorIs(t, err, kv.ErrNotFound)
+	}
+
+	require.Equal(t, float64(2), testutil.ToFloat64(s.metrics.hits))
+	require.Equal(t, float64(2), testutil.ToFloat64(s.metrics.negativeHits))
+	require.Equal(t, float64(2), testutil.ToFloat64(s.metrics.misses))
+	require.Equal(t, float64(2), testutil.ToFloat64(s.metrics.entries))
+
+	count, err := testutil.GatherAndCount(reg)
+	require.NoError(t, err)
+	require.Equal(t, 6, count)
+
+	// The metrics are unregistered on close, a new cache registers them
+	// again.
+	require.NoError(t, s.Close())
+	s, _ = newCached(t, Config{MaxBytes: 1 << 20}, reg)
+	require.NoError(t, s.Close())
+}
+
+func TestCacheCompact(t *testing.T) {
+	s, _ := newCached(t, Config{MaxBytes: 1 << 20}, nil)
+	defer s.Close()
+
+	_, err := s.Compact(context.Background())
+	require.ErrorIs(t, err, kv.ErrCompactUnsupported)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	for _, cfg := range []Config{{}, {MaxBytes: 1, TTL: -1}, {MaxBytes: 1, NegativeTTL: -1}} {
+		_, err := New(cfg, Dependencies{Store: mapkv.NewStore()})
+		require.Error(t, err)
+	}
+}
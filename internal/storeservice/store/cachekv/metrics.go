@@ -0,0 +1,72 @@
+package cachekv
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type metrics struct {
+	hits         prometheus.Counter
+	negativeHits prometheus.Counter
+	misses       prometheus.Counter
+	evictions    prometheus.Counter
+	bytes        prometheus.GaugeFunc
+	entries      prometheus.GaugeFunc
+}
+
+func newMetrics(s *Store) *metrics {
+	usage := func(entries bool) float64 {
+		var total int64
+		for _, sh := range s.shards {
+			bytes, n := sh.usage()
+			if entries {
+				total += n
+			} else {
+				total += bytes
+			}
+		}
+		return float64(total)
+	}
+
+	return &metrics{
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "cachekv_hits_total",
+			Help: "Reads served with a value from the cache.",
+		}),
+		negativeHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "cachekv_negative_hits_total",
+			Help: "Reads of missing keys served from the cache.",
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "cachekv_misses_total",
+			Help: "Reads which went to the store.",
+		}),
+		evictions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "cachekv_evictions_total",
+			Help: "Entries evicted to keep the cache within its size.",
+		}),
+		bytes: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "cachekv_bytes",
+			Help: "Estimated memory of the cached entries.",
+		}, func() float64 { return usage(false) }),
+		entries: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "cachekv_entries",
+			Help: "Cached entries, including missing keys.",
+		}, func() float64 { return usage(true) }),
+	}
+}
+
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.hits.Describe(ch)
+	m.negativeHits.Describe(ch)
+	m.misses.Describe(ch)
+	m.evictions.Describe(ch)
+	m.bytes.Describe(ch)
+	m.entries.Describe(ch)
+}
+
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.hits.Collect(ch)
+	m.negativeHits.Collect(ch)
+	m.misses.Collect(ch)
+	m.evictions.Collect(ch)
+	m.bytes.Collect(ch)
+	m.entries.Collect(ch)
+}
@@ -0,0 +1,136 @@
+package cachekv
+
+import (
+	"container/list"
+	"kvstore/internal/storeservice/store/kv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// entryOverhead estimates the memory an entry takes besides its key and
+// value: the entry itself, its list element and its slot in the map.
+const entryOverhead = 160
+
+type entry struct {
+	key     string
+	value   kv.Value
+	version uint64
+	// missing marks a key which was not found in the store.
+	missing   bool
+	expiresAt time.Time
+}
+
+func (e *entry) size() int64 {
+	return int64(len(e.key)+len(e.value)) + entryOverhead
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// shard is an LRU cache of a part of the keys.
+type shard struct {
+	mu       sync.Mutex
+	maxBytes int64
+	bytes    int64
+	// order has the most recently used entry in front.
+	order *list.List
+	elems map[string]*list.Element
+	// gen changes on every invalidation. A read remembers it before it
+	// goes to the store and fills the cache only if it did not change
+	// meanwhile, otherwise the value it read may be older than the
+	// write which invalidated the key.
+	gen       uint64
+	evictions prometheus.Counter
+}
+
+func newShard(maxBytes int64) *shard {
+	return &shard{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		elems:    make(map[string]*list.Element),
+	}
+}
+
+// get returns the cached entry of the key. On a miss it returns the
+// generation to pass to fill.
+func (sh *shard) get(key string, now time.Time) (entry, uint64, bool) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	el, ok := sh.elems[key]
+	if !ok {
+		return entry{}, sh.gen, false
+	}
+	e := el.Value.(*entry)
+	if e.expired(now) {
+		sh.remove(el)
+		return entry{}, sh.gen, false
+	}
+	sh.order.MoveToFront(el)
+	return *e, 0, true
+}
+
+// fill caches the entry read from the store unless a key of the shard
+// was invalidated since gen.
+func (sh *shard) fill(gen uint64, e entry) {
+	size := e.size()
+	if size > sh.maxBytes {
+		return
+	}
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if gen != sh.gen {
+		return
+	}
+
+	if el, ok := sh.elems[e.key]; ok {
+		sh.remove(el)
+	}
+	sh.elems[e.key] = sh.order.PushFront(&e)
+	sh.bytes += size
+	for sh.bytes > sh.maxBytes {
+		sh.remove(sh.order.Back())
+		sh.evictions.Inc()
+	}
+}
+
+func (sh *shard) invalidate(key string) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	sh.gen++
+	if el, ok := sh.elems[key]; ok {
+		sh.remove(el)
+	}
+}
+
+// invalidateRange drops the keys in [start, end), unbounded drops every
+// key from start.
+func (sh *shard) invalidateRange(start, end string, unbounded bool) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	sh.gen++
+	for key, el := range sh.elems {
+		if key >= start && (unbounded || key < end) {
+			sh.remove(el)
+		}
+	}
+}
+
+func (sh *shard) remove(el *list.Element) {
+	e := sh.order.Remove(el).(*entry)
+	delete(sh.elems, e.key)
+	sh.bytes -= e.size()
+}
+
+// usage returns the estimated memory and the number of the entries.
+func (sh *shard) usage() (int64, int64) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.bytes, int64(len(sh.elems))
+}
@@ -0,0 +1,31 @@
+package kv
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+)
+
+// DirSize returns the disk space taken by the files in dir for Stats of
+// the backends keeping their files there. Files removed while it runs
+// are skipped.
+func DirSize(dir string) (int64, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, f := range files {
+		info, err := f.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		if info.Mode().IsRegular() {
+			size += DiskSize(info)
+		}
+	}
+	return size, nil
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package kv
+
+import "io/fs"
+
+// DiskSize returns the size of the file, the allocated space is not
+// known on this platform.
+func DiskSize(info fs.FileInfo) int64 {
+	return info.Size()
+}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package kv
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+// DiskSize returns the disk space allocated to the file, which is less
+// than its size for preallocated sparse files.
+func DiskSize(info fs.FileInfo) int64 {
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		return st.Blocks * 512
+	}
+	return info.Size()
+}
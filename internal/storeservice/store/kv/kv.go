@@ -1,12 +1,44 @@
 package kv
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"time"
 )
 
 var ErrNotFound = errors.New("not found")
 var ErrStopScan = errors.New("stop scan")
+var ErrUnknownOp = errors.New("unknown operation")
+
+// ErrConflict is returned when a conditional write does not match the
+// current state of the key.
+var ErrConflict = errors.New("conflict")
+
+// ErrTxnClosed is returned when a committed or rolled back transaction
+// is used.
+var ErrTxnClosed = errors.New("transaction closed")
+
+// ErrUnsupportedBackup is returned when a backup format can not be
+// restored by the backend.
+var ErrUnsupportedBackup = errors.New("unsupported backup format")
+
+// ErrWatchLagged is returned by Watch when the watcher fell too far
+// behind the writes. It can watch again from the last version it got.
+var ErrWatchLagged = errors.New("watch lagged behind")
+
+// ErrClosed is returned by Watch when the store is closed.
+var ErrClosed = errors.New("store closed")
+
+// ErrCompactUnsupported is returned by a store which wraps another one
+// and implements Compactor when the wrapped store does not.
+var ErrCompactUnsupported = errors.New("backend does not support compaction")
+
+// ErrExpiryUnsupported is returned by a store which wraps another one
+// and implements Expirer when the wrapped store does not.
+var ErrExpiryUnsupported = errors.New("backend does not tell the expiry of keys")
 
 type (
 	Key   []byte
@@ -16,13 +48,222 @@ type (
 type ScanOptions struct {
 	Limit  int
 	Prefix Key
+	// Start is the inclusive lower bound of the scan.
+	Start Key
+	// End is the exclusive upper bound of the scan.
+	End Key
+	// Reverse iterates keys in descending order.
+	Reverse bool
+	// After resumes the scan strictly after the key in the scan order,
+	// that is below it for a reverse scan.
+	After Key
+}
+
+// Bounds returns the effective key range [lower, upper) of the scan
+// combining Prefix, Start, End and After. A nil upper bound means
+// unbounded.
+func (o ScanOptions) Bounds() (lower, upper Key) {
+	lower = o.Prefix
+	if bytes.Compare(o.Start, lower) > 0 {
+		lower = o.Start
+	}
+
+	upper = prefixEnd(o.Prefix)
+	if len(o.End) != 0 && (upper == nil || bytes.Compare(o.End, upper) < 0) {
+		upper = o.End
+	}
+
+	if len(o.After) != 0 {
+		if o.Reverse {
+			if upper == nil || bytes.Compare(o.After, upper) < 0 {
+				upper = o.After
+			}
+		} else if next := append(bytes.Clone(o.After), 0); bytes.Compare(next, lower) > 0 {
+			// The key followed by a zero byte is the smallest one after it.
+			lower = next
+		}
+	}
+	return lower, upper
+}
+
+// prefixEnd returns the smallest key greater than every key with the
+// given prefix or nil if there is no such key.
+func prefixEnd(prefix Key) Key {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
 }
 
 type ScanHandler func(Key, Value) error
 
+type OpType int
+
+const (
+	OpSet OpType = iota
+	OpDelete
+)
+
+// Op is a single write applied as part of a batch.
+type Op struct {
+	Type  OpType
+	Key   Key
+	Value Value
+	// TTL is only used by OpSet, zero means the key never expires.
+	TTL time.Duration
+}
+
+// ValidateOps checks that every operation of the batch is known, so
+// that the batch can be rejected before anything is applied.
+func ValidateOps(ops []Op) error {
+	for _, op := range ops {
+		if op.Type != OpSet && op.Type != OpDelete {
+			return fmt.Errorf("%w: %d", ErrUnknownOp, op.Type)
+		}
+	}
+	return nil
+}
+
+type EventType int
+
+const (
+	EventPut EventType = iota
+	EventDelete
+)
+
+// Event is a change of a key observed by Watch. Value is nil for
+// EventDelete.
+type Event struct {
+	Type    EventType
+	Key     Key
+	Value   Value
+	Version uint64
+}
+
+type WatchHandler func(Event) error
+
+// Stats describes the size of the data of a store.
+type Stats struct {
+	// Keys is the number of live keys.
+	Keys int64
+	// LogicalBytes is the total size of the live keys and values as
+	// they were written.
+	LogicalBytes int64
+	// DiskBytes is the disk space taken by the files of the store
+	// including the space not reclaimed yet, zero for in-memory stores.
+	DiskBytes int64
+	// LSMBytes and VlogBytes split DiskBytes of Badger between its LSM
+	// tree and value log, other backends leave them zero.
+	LSMBytes  int64
+	VlogBytes int64
+}
+
+// Store is a key-value store. Every operation returns ctx.Err() once its
+// context is done: a write whose context is done before it starts is not
+// applied, scans, counts and other long operations stop promptly. Scan
+// handlers run without the locks of the store, so they may call it.
 type Store interface {
 	Set(context.Context, Key, Value) error
+	// SetWithTTL stores the value and expires it after ttl. Zero ttl
+	// means the key never expires.
+	SetWithTTL(context.Context, Key, Value, time.Duration) error
 	Get(context.Context, Key) (Value, error)
+	// GetWithVersion returns the value together with its version. The
+	// version changes on every write of the key and is never zero.
+	GetWithVersion(context.Context, Key) (Value, uint64, error)
 	Delete(context.Context, Key) error
+	// DeletePrefix removes the keys with the prefix and returns the
+	// number of live keys it removed. Many keys are removed in batches,
+	// a failure may leave a part of them removed, the number returned
+	// with the error is the live keys removed before it.
+	DeletePrefix(ctx context.Context, prefix Key) (int64, error)
+	// DeleteRange is DeletePrefix for the keys in [start, end), empty
+	// end means no upper bound.
+	DeleteRange(ctx context.Context, start, end Key) (int64, error)
+
+	// SetIfVersion replaces the value only if the key exists with the
+	// given version.
+	SetIfVersion(context.Context, Key, Value, uint64) error
+	// SetIfAbsent stores the value only if the key does not exist.
+	SetIfAbsent(context.Context, Key, Value) error
+	// DeleteIfVersion removes the key only if it exists with the given
+	// version.
+	DeleteIfVersion(context.Context, Key, uint64) error
+
+	Scan(context.Context, ScanOptions, ScanHandler) error
+	// Write applies all operations atomically: either every operation
+	// is visible or none of them.
+	Write(context.Context, []Op) error
+	// Begin starts an interactive read-write transaction.
+	Begin(context.Context) (Txn, error)
+	// Snapshot returns a read-only view of the store at this moment.
+	Snapshot(context.Context) (Snapshot, error)
+	// Watch calls f with the changes of the keys with the prefix until
+	// ctx is done or f fails. Events of a key come in version order. A
+	// non-zero fromVersion first replays the current values of the keys
+	// written after it as EventPut, keys deleted meanwhile are not
+	// replayed. Expiration produces no events.
+	Watch(ctx context.Context, prefix Key, fromVersion uint64, f WatchHandler) error
+
+	// Count returns the number of live keys with the prefix without
+	// reading their values.
+	Count(ctx context.Context, prefix Key) (int64, error)
+	// CountRange is Count for the keys in [start, end), empty end means
+	// no upper bound.
+	CountRange(ctx context.Context, start, end Key) (int64, error)
+	Stats(context.Context) (Stats, error)
+
+	// Backup writes every entry with a version greater than since to w,
+	// zero since takes a full backup. It returns the version to pass as
+	// since to take the next incremental backup.
+	Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error)
+	// Restore loads a backup on top of the current data of the store.
+	// Every backend accepts the portable kvdump format.
+	Restore(ctx context.Context, r io.Reader) error
+	Close() error
+}
+
+// Compactor is implemented by the stores which can reclaim the disk
+// space of overwritten and deleted entries on demand.
+type Compactor interface {
+	// Compact returns the number of bytes it freed on disk.
+	Compact(context.Context) (int64, error)
+}
+
+// Expirer is implemented by the stores which can tell when a key
+// expires.
+type Expirer interface {
+	// GetWithExpiry is GetWithVersion which also returns the time the
+	// key expires at, zero when it never does.
+	GetWithExpiry(context.Context, Key) (Value, uint64, time.Time, error)
+}
+
+// Snapshot is a consistent read-only view of the store, writes made after
+// it was taken are not visible. It must be released with Close.
+type Snapshot interface {
+	Get(context.Context, Key) (Value, error)
 	Scan(context.Context, ScanOptions, ScanHandler) error
+	Close() error
+}
+
+// Txn is an optimistic transaction. Reads observe the state of the store
+// at the moment the transaction began together with its own writes,
+// writes are buffered until Commit. Commit fails with ErrConflict if any
+// key read by the transaction was modified by someone else meanwhile.
+type Txn interface {
+	Get(context.Context, Key) (Value, error)
+	Set(context.Context, Key, Value) error
+	// SetWithTTL is Set which expires the value after ttl, zero ttl
+	// means the key never expires.
+	SetWithTTL(context.Context, Key, Value, time.Duration) error
+	Delete(context.Context, Key) error
+	// Commit with a done context discards the transaction and returns
+	// ctx.Err().
+	Commit(context.Context) error
+	// Rollback discards the transaction, it is a no-op after Commit.
+	Rollback() error
 }
@@ -0,0 +1,164 @@
+// Package kvdump implements a portable backup format that can be
+// produced and restored by any kv.Store backend.
+//
+// A dump starts with a magic header followed by records. Every record is
+// a type byte and, for entries, the key, the value, the version and the
+// expiration time. The end record makes truncated dumps detectable.
+package kvdump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+)
+
+var magic = []byte("KVDUMP01")
+
+var ErrInvalidDump = errors.New("invalid dump")
+
+const (
+	recordEnd   byte = 0
+	recordEntry byte = 1
+)
+
+type Entry struct {
+	Key     kv.Key
+	Value   kv.Value
+	Version uint64
+	// ExpiresAt is the moment the entry expires, zero means never.
+	ExpiresAt time.Time
+}
+
+// IsDump reports whether the buffered reader is positioned at the start
+// of a dump. It does not consume any input.
+func IsDump(r *bufio.Reader) bool {
+	header, err := r.Peek(len(magic))
+	return err == nil && bytes.Equal(header, magic)
+}
+
+type Writer struct {
+	w   *bufio.Writer
+	buf []byte
+}
+
+func NewWriter(w io.Writer) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(magic); err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		w: bw,
+	}, nil
+}
+
+func (w *Writer) Write(e Entry) error {
+	var expiresAt int64
+	if !e.ExpiresAt.IsZero() {
+		expiresAt = e.ExpiresAt.UnixNano()
+	}
+
+	w.buf = append(w.buf[:0], recordEntry)
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(e.Key)))
+	w.buf = append(w.buf, e.Key...)
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(e.Value)))
+	w.buf = append(w.buf, e.Value...)
+	w.buf = binary.AppendUvarint(w.buf, e.Version)
+	w.buf = binary.AppendVarint(w.buf, expiresAt)
+
+	_, err := w.w.Write(w.buf)
+	return err
+}
+
+// Close writes the end record and flushes the buffered data. It does not
+// close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.w.WriteByte(recordEnd); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+type Reader struct {
+	r *bufio.Reader
+}
+
+func NewReader(r io.Reader) (*Reader, error) {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+
+	header := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, header); err != nil || !bytes.Equal(header, magic) {
+		return nil, ErrInvalidDump
+	}
+
+	return &Reader{
+		r: br,
+	}, nil
+}
+
+// Next returns the next entry of the dump or io.EOF after the end record.
+func (r *Reader) Next() (Entry, error) {
+	typ, err := r.r.ReadByte()
+	if err != nil {
+		return Entry{}, unexpected(err)
+	}
+
+	switch typ {
+	case recordEnd:
+		return Entry{}, io.EOF
+	case recordEntry:
+	default:
+		return Entry{}, fmt.Errorf("%w: unknown record type %d", ErrInvalidDump, typ)
+	}
+
+	var e Entry
+	if e.Key, err = r.readBytes(); err != nil {
+		return Entry{}, err
+	}
+	if e.Value, err = r.readBytes(); err != nil {
+		return Entry{}, err
+	}
+	if e.Version, err = binary.ReadUvarint(r.r); err != nil {
+		return Entry{}, unexpected(err)
+	}
+
+	expiresAt, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return Entry{}, unexpected(err)
+	}
+	if expiresAt != 0 {
+		e.ExpiresAt = time.Unix(0, expiresAt)
+	}
+
+	return e, nil
+}
+
+func (r *Reader) readBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, unexpected(err)
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return nil, unexpected(err)
+	}
+	return b, nil
+}
+
+// unexpected converts EOF in the middle of a dump into an error, a
+// complete dump always ends with the end record.
+func unexpected(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
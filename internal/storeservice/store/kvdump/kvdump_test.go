@@ -0,0 +1,73 @@
+package kvdump
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteRead(t *testing.T) {
+	entries := []Entry{
+		{Key: kv.Key("a"), Value: kv.Value("1"), Version: 1},
+		{Key: kv.Key("b"), Value: kv.Value{}, Version: 2, ExpiresAt: time.Unix(0, 1700000000123456789)},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	require.NoError(t, err)
+	for _, e := range entries {
+		require.NoError(t, w.Write(e))
+	}
+	require.NoError(t, w.Close())
+
+	require.True(t, IsDump(bufio.NewReader(bytes.NewReader(buf.Bytes()))))
+
+	r, err := NewReader(&buf)
+	require.NoError(t, err)
+
+	var got []Entry
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, e)
+	}
+	require.Equal(t, len(entries), len(got))
+	for i := range entries {
+		require.Equal(t, entries[i].Key, got[i].Key)
+		require.Equal(t, []byte(entries[i].Value), []byte(got[i].Value))
+		require.Equal(t, entries[i].Version, got[i].Version)
+		require.True(t, entries[i].ExpiresAt.Equal(got[i].ExpiresAt))
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(Entry{Key: kv.Key("key"), Value: kv.Value("value")}))
+	require.NoError(t, w.Close())
+
+	// Without the end record the dump is incomplete.
+	data := buf.Bytes()[:buf.Len()-1]
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	_, err = r.Next()
+	require.NoError(t, err)
+	_, err = r.Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestInvalidHeader(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("garbage!")))
+	require.ErrorIs(t, err, ErrInvalidDump)
+	require.False(t, IsDump(bufio.NewReader(bytes.NewReader([]byte("garbage!")))))
+}
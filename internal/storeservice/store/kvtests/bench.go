@@ -0,0 +1,58 @@
+package kvtests
+
+import (
+	"context"
+	"fmt"
+	"kvstore/internal/storeservice/bench"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RunBenchmarks runs the standard workloads against the store with every
+// value size and number of workers. Each workload and value size gets a
+// store of its own, preloaded when the workload reads. Besides ns/op it
+// reports the median and the 99th percentile latency of an operation.
+func RunBenchmarks(b *testing.B, newStore Factory) {
+	ctx := context.Background()
+
+	for _, wl := range bench.Workloads {
+		b.Run(wl.Name, func(b *testing.B) {
+			for _, size := range bench.ValueSizes {
+				b.Run(fmt.Sprintf("value=%d", size), func(b *testing.B) {
+					s := newStore(b)
+					defer func() {
+						require.NoError(b, s.Close())
+					}()
+
+					target := bench.StoreTarget(s)
+					cfg := bench.Config{
+						Prefix:    bench.DefaultPrefix,
+						Keys:      bench.DefaultKeys,
+						ValueSize: size,
+						Workers:   bench.Parallelism[len(bench.Parallelism)-1],
+					}
+					if wl.Preload {
+						require.NoError(b, bench.Preload(ctx, target, cfg))
+					}
+
+					for _, workers := range bench.Parallelism {
+						b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+							cfg := cfg
+							cfg.Workers = workers
+
+							b.SetBytes(int64(size))
+							b.ResetTimer()
+							res := bench.RunN(ctx, target, wl, cfg, b.N)
+							b.StopTimer()
+
+							require.NoError(b, res.Err)
+							b.ReportMetric(float64(res.Percentile(50).Nanoseconds()), "p50-ns")
+							b.ReportMetric(float64(res.Percentile(99).Nanoseconds()), "p99-ns")
+						})
+					}
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package kvtests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	concurrentWorkers = 4
+	concurrentRounds  = 50
+	// concurrentBatch is the number of keys every batch of a writer
+	// sets, readers must see all of them with the same value.
+	concurrentBatch = 5
+)
+
+// runWorkers runs f in n goroutines and fails the test with their
+// errors once all of them are done.
+func runWorkers(t *testing.T, n int, f func(worker int) error) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- f(i)
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+// testConcurrentReadersWriters runs writers of batches and single keys
+// together with readers which check that every batch is seen whole and
+// that scans stay ordered.
+func testConcurrentReadersWriters(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	batchKey := func(writer, i int) kv.Key {
+		return kv.Key(fmt.Sprintf("conc/batch/%d/%d", writer, i))
+	}
+
+	var writing atomic.Int32
+	writing.Store(concurrentWorkers)
+
+	write := func(writer int) error {
+		defer writing.Add(-1)
+
+		for round := 1; round <= concurrentRounds; round++ {
+			value := kv.Value(strconv.Itoa(round))
+			ops := make([]kv.Op, concurrentBatch)
+			for i := range ops {
+				ops[i] = kv.Op{Type: kv.OpSet, Key: batchKey(writer, i), Value: value}
+			}
+			if err := s.Write(ctx, ops); err != nil {
+				return err
+			}
+
+			key := kv.Key(fmt.Sprintf("conc/single/%d/%d", writer, round%concurrentBatch))
+			if err := s.Set(ctx, key, value); err != nil {
+				return err
+			}
+			if round%10 == 0 {
+				if err := s.Delete(ctx, key); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	// checkBatches checks that the batches of every writer in the scan
+	// have all their keys with one value.
+	checkBatches := func(scan func(context.Context, kv.ScanOptions, kv.ScanHandler) error) error {
+		values := map[string][]string{}
+		var last kv.Key
+		err := scan(ctx, kv.ScanOptions{Prefix: kv.Key("conc/batch/")}, func(k kv.Key, v kv.Value) error {
+			if last != nil && string(k) <= string(last) {
+				return fmt.Errorf("scan returned %q after %q", k, last)
+			}
+			last = append(last[:0], k...)
+
+			writer := string(k[:len("conc/batch/0")])
+			values[writer] = append(values[writer], string(v))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for writer, vs := range values {
+			if len(vs) != concurrentBatch {
+				return fmt.Errorf("batch %s has %d keys", writer, len(vs))
+			}
+			for _, v := range vs {
+				if v != vs[0] {
+					return fmt.Errorf("batch %s is torn: %v", writer, vs)
+				}
+			}
+		}
+		return nil
+	}
+
+	read := func(reader int) error {
+		r := rand.New(rand.NewSource(int64(reader)))
+		for writing.Load() > 0 {
+			if err := checkBatches(s.Scan); err != nil {
+				return err
+			}
+
+			snap, err := s.Snapshot(ctx)
+			if err != nil {
+				return err
+			}
+			err = checkBatches(snap.Scan)
+			snap.Close()
+			if err != nil {
+				return err
+			}
+
+			key := batchKey(r.Intn(concurrentWorkers), r.Intn(concurrentBatch))
+			v, err := s.Get(ctx, key)
+			if errors.Is(err, kv.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if n, err := strconv.Atoi(string(v)); err != nil || n < 1 || n > concurrentRounds {
+				return fmt.Errorf("%q has value %q no writer wrote", key, v)
+			}
+
+			if _, err := s.Count(ctx, kv.Key("conc/single/")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	runWorkers(t, 2*concurrentWorkers, func(worker int) error {
+		if worker < concurrentWorkers {
+			return write(worker)
+		}
+		return read(worker)
+	})
+
+	for writer := 0; writer < concurrentWorkers; writer++ {
+		for i := 0; i < concurrentBatch; i++ {
+			v, err := s.Get(ctx, batchKey(writer, i))
+			require.NoError(t, err)
+			require.Equal(t, kv.Value(strconv.Itoa(concurrentRounds)), v)
+		}
+	}
+	n, err := s.Count(ctx, kv.Key("conc/single/"))
+	require.NoError(t, err)
+	require.Equal(t, int64(concurrentWorkers*(concurrentBatch-1)), n)
+}
+
+// testConcurrentTxns increments counters in transactions retried on
+// conflicts, no increment may be lost.
+func testConcurrentTxns(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	const counters = 2
+	counterKey := func(i int) kv.Key {
+		return kv.Key(fmt.Sprintf("conc/txn/%d", i))
+	}
+
+	increment := func() error {
+		for {
+			txn, err := s.Begin(ctx)
+			if err != nil {
+				return err
+			}
+
+			for i := 0; i < counters; i++ {
+				n := 0
+				v, err := txn.Get(ctx, counterKey(i))
+				switch {
+				case err == nil:
+					if n, err = strconv.Atoi(string(v)); err != nil {
+						txn.Rollback()
+						return err
+					}
+				case !errors.Is(err, kv.ErrNotFound):
+					txn.Rollback()
+					return err
+				}
+				if err := txn.Set(ctx, counterKey(i), kv.Value(strconv.Itoa(n+1))); err != nil {
+					txn.Rollback()
+					return err
+				}
+			}
+
+			err = txn.Commit(ctx)
+			if errors.Is(err, kv.ErrConflict) {
+				continue
+			}
+			return err
+		}
+	}
+
+	runWorkers(t, concurrentWorkers, func(int) error {
+		for i := 0; i < concurrentRounds; i++ {
+			if err := increment(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	for i := 0; i < counters; i++ {
+		v, err := s.Get(ctx, counterKey(i))
+		require.NoError(t, err)
+		require.Equal(t, kv.Value(strconv.Itoa(concurrentWorkers*concurrentRounds)), v)
+	}
+}
@@ -0,0 +1,162 @@
+package kvtests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"reflect"
+	"runtime"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// evictionKeys is the number of keys the eviction tests write, it should
+// exceed the budget of the store under test several times.
+const evictionKeys = 2000
+
+// RunEvictionTests checks a store which evicts keys to stay within a
+// budget. Any key may be gone, but a present key has its latest value,
+// deleted keys never come back and the keys of the last write are kept.
+// Every test gets a fresh store from newStore.
+func RunEvictionTests(t *testing.T, newStore Factory) {
+	tests := []func(*testing.T, kv.Store){
+		testEvictionSetGet,
+		testEvictionOverwrite,
+		testEvictionDelete,
+		testEvictionScan,
+		testEvictionWrite,
+		testEvictionTTL,
+	}
+
+	for _, test := range tests {
+		testName := runtime.FuncForPC(reflect.ValueOf(test).Pointer()).Name()
+		t.Run(testName, func(t *testing.T) {
+			s := newStore(t)
+			t.Cleanup(func() {
+				require.NoError(t, s.Close())
+			})
+			test(t, s)
+		})
+	}
+}
+
+// requireValueOrEvicted checks that the key has the value or is gone.
+func requireValueOrEvicted(t *testing.T, s kv.Store, key, value string) bool {
+	t.Helper()
+	val, err := s.Get(context.Background(), kv.Key(key))
+	if errors.Is(err, kv.ErrNotFound) {
+		return false
+	}
+	require.NoError(t, err, key)
+	require.Equal(t, kv.Value(value), val, key)
+	return true
+}
+
+func fillEvicting(t *testing.T, s kv.Store, prefix string, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		err := s.Set(context.Background(), kv.Key(fmt.Sprintf("%s%04d", prefix, i)), kv.Value(fmt.Sprintf("value-%04d", i)))
+		require.NoError(t, err)
+	}
+}
+
+func testEvictionSetGet(t *testing.T, s kv.Store) {
+	fillEvicting(t, s, "evict/get/", evictionKeys)
+
+	for i := 0; i < evictionKeys; i++ {
+		requireValueOrEvicted(t, s, fmt.Sprintf("evict/get/%04d", i), fmt.Sprintf("value-%04d", i))
+	}
+	last := fmt.Sprintf("evict/get/%04d", evictionKeys-1)
+	require.True(t, requireValueOrEvicted(t, s, last, fmt.Sprintf("value-%04d", evictionKeys-1)), "last written key evicted")
+}
+
+func testEvictionOverwrite(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	for round := 0; round < 5; round++ {
+		for i := 0; i < evictionKeys/5; i++ {
+			key := kv.Key(fmt.Sprintf("evict/overwrite/%04d", i))
+			require.NoError(t, s.Set(ctx, key, kv.Value(fmt.Sprintf("round-%d", round))))
+		}
+	}
+
+	for i := 0; i < evictionKeys/5; i++ {
+		requireValueOrEvicted(t, s, fmt.Sprintf("evict/overwrite/%04d", i), "round-4")
+	}
+}
+
+func testEvictionDelete(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	fillEvicting(t, s, "evict/delete/", evictionKeys/2)
+	for i := 0; i < evictionKeys/2; i += 2 {
+		require.NoError(t, s.Delete(ctx, kv.Key(fmt.Sprintf("evict/delete/%04d", i))))
+	}
+	fillEvicting(t, s, "evict/delete-fill/", evictionKeys/2)
+
+	for i := 0; i < evictionKeys/2; i++ {
+		key := fmt.Sprintf("evict/delete/%04d", i)
+		if i%2 == 0 {
+			_, err := s.Get(ctx, kv.Key(key))
+			require.ErrorIs(t, err, kv.ErrNotFound, key)
+			continue
+		}
+		requireValueOrEvicted(t, s, key, fmt.Sprintf("value-%04d", i))
+	}
+}
+
+func testEvictionScan(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	fillEvicting(t, s, "evict/scan/", evictionKeys)
+
+	var keys []string
+	err := s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key("evict/scan/")}, func(k kv.Key, v kv.Value) error {
+		var i int
+		_, err := fmt.Sscanf(string(k), "evict/scan/%04d", &i)
+		require.NoError(t, err)
+		require.Equal(t, kv.Value(fmt.Sprintf("value-%04d", i)), v, string(k))
+		keys = append(keys, string(k))
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, keys)
+	require.True(t, sort.StringsAreSorted(keys), "scan must return keys in lexicographic order")
+
+	count, err := s.Count(ctx, kv.Key("evict/scan/"))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(keys)), count)
+}
+
+func testEvictionWrite(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	for round := 0; round < evictionKeys/10; round++ {
+		ops := make([]kv.Op, 0, 10)
+		for i := 0; i < 10; i++ {
+			ops = append(ops, kv.Op{
+				Type:  kv.OpSet,
+				Key:   kv.Key(fmt.Sprintf("evict/batch/%04d/%d", round, i)),
+				Value: kv.Value("val"),
+			})
+		}
+		require.NoError(t, s.Write(ctx, ops))
+
+		// A write never evicts its own keys.
+		for _, op := range ops {
+			require.True(t, requireValueOrEvicted(t, s, string(op.Key), "val"), string(op.Key))
+		}
+	}
+}
+
+func testEvictionTTL(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	require.NoError(t, s.SetWithTTL(ctx, kv.Key("evict/ttl"), kv.Value("val"), time.Hour))
+	require.True(t, requireValueOrEvicted(t, s, "evict/ttl", "val"))
+	fillEvicting(t, s, "evict/ttl-fill/", evictionKeys)
+	requireValueOrEvicted(t, s, "evict/ttl", "val")
+}
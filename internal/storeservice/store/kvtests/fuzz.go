@@ -0,0 +1,91 @@
+package kvtests
+
+import (
+	"bytes"
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// maxFuzzKey bounds the keys of Fuzz, Badger rejects keys over 64KB.
+const maxFuzzKey = 1 << 10
+
+// Fuzz checks that the store keeps keys and values of arbitrary bytes
+// as they are through every way of writing and reading them. One store
+// serves all the inputs. The seeds include empty values and values much
+// larger than the buffers of the backends.
+func Fuzz(f *testing.F, newStore Factory) {
+	f.Add([]byte("key"), []byte("value"))
+	f.Add([]byte{0}, []byte{})
+	f.Add([]byte{0xff}, []byte{0})
+	f.Add([]byte{0xff, 0xff, 0}, []byte{0xff, 0, 0xfe})
+	f.Add([]byte("a/\x00/b"), bytes.Repeat([]byte("large"), 1<<10))
+	// In memory Badger takes values up to 1MB.
+	f.Add([]byte("huge"), bytes.Repeat([]byte{0xab}, 512<<10))
+
+	s := newStore(f)
+	f.Cleanup(func() {
+		require.NoError(f, s.Close())
+	})
+
+	f.Fuzz(func(t *testing.T, key, value []byte) {
+		if len(key) == 0 {
+			t.Skip("the empty key is not supported by every backend")
+		}
+		if len(key) > maxFuzzKey {
+			t.Skip("key too large")
+		}
+
+		var (
+			ctx = context.Background()
+			k   = kv.Key(key)
+			v   = kv.Value(value)
+		)
+		requireValue := func(want kv.Value) {
+			t.Helper()
+			got, version, err := s.GetWithVersion(ctx, k)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(want, got), "value of %d bytes read back as %d bytes", len(want), len(got))
+			require.NotZero(t, version)
+
+			var scanned int
+			err = s.Scan(ctx, kv.ScanOptions{Prefix: k, Limit: 1}, func(sk kv.Key, sv kv.Value) error {
+				scanned++
+				require.Equal(t, k, sk)
+				require.True(t, bytes.Equal(want, sv))
+				return nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, 1, scanned)
+		}
+		requireMissing := func() {
+			t.Helper()
+			_, err := s.Get(ctx, k)
+			require.ErrorIs(t, err, kv.ErrNotFound)
+		}
+
+		require.NoError(t, s.Set(ctx, k, v))
+		requireValue(v)
+		require.NoError(t, s.Delete(ctx, k))
+		requireMissing()
+
+		require.NoError(t, s.Write(ctx, []kv.Op{{Type: kv.OpSet, Key: k, Value: v}}))
+		requireValue(v)
+		require.NoError(t, s.Write(ctx, []kv.Op{{Type: kv.OpDelete, Key: k}}))
+		requireMissing()
+
+		require.NoError(t, s.SetIfAbsent(ctx, k, v))
+		requireValue(v)
+
+		txn, err := s.Begin(ctx)
+		require.NoError(t, err)
+		got, err := txn.Get(ctx, k)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(v, got))
+		require.NoError(t, txn.Delete(ctx, k))
+		require.NoError(t, txn.Commit(ctx))
+		requireMissing()
+	})
+}
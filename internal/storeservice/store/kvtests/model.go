@@ -0,0 +1,377 @@
+package kvtests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// modelOps is the number of random operations testModel applies.
+const modelOps = 2000
+
+// modelAlphabet makes up the keys of the model test. The zero and 0xff
+// bytes exercise the ordering and the prefix bounds, few letters make
+// the keys collide often.
+var modelAlphabet = []byte{0x00, 'a', 'b', 0xff}
+
+// model is the reference the store is checked against, the live keys
+// with their values.
+type model map[string]string
+
+func (m model) clone() model {
+	c := make(model, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// scan returns the keys the scan should return in its order.
+func (m model) scan(opts kv.ScanOptions) []string {
+	lower, upper := opts.Bounds()
+
+	var keys []string
+	for k := range m {
+		if k >= string(lower) && (upper == nil || k < string(upper)) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	if opts.Reverse {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+	if opts.Limit > 0 && len(keys) > opts.Limit {
+		keys = keys[:opts.Limit]
+	}
+	return keys
+}
+
+// modelRun applies random operations to the store and the model and
+// checks that they agree.
+type modelRun struct {
+	t *testing.T
+	s kv.Store
+	r *rand.Rand
+	m model
+}
+
+func testModel(t *testing.T, s kv.Store) {
+	seed := time.Now().UnixNano()
+	t.Logf("seed %d", seed)
+
+	run := &modelRun{t: t, s: s, r: rand.New(rand.NewSource(seed)), m: model{}}
+	steps := []func(context.Context){
+		run.set,
+		run.delete,
+		run.setIfAbsent,
+		run.setIfVersion,
+		run.deleteIfVersion,
+		run.write,
+		run.deleteRange,
+		run.txn,
+		run.get,
+		run.scan,
+		run.count,
+		run.snapshot,
+	}
+	for i := 0; i < modelOps; i++ {
+		steps[run.r.Intn(len(steps))](context.Background())
+	}
+
+	run.checkScan(context.Background(), s.Scan, kv.ScanOptions{}, run.m)
+}
+
+func (run *modelRun) key() kv.Key {
+	k := make(kv.Key, 1+run.r.Intn(3))
+	for i := range k {
+		k[i] = modelAlphabet[run.r.Intn(len(modelAlphabet))]
+	}
+	return k
+}
+
+// prefix is a prefix of a random key, empty included.
+func (run *modelRun) prefix() kv.Key {
+	k := run.key()
+	return k[:run.r.Intn(len(k)+1)]
+}
+
+func (run *modelRun) value() kv.Value {
+	if run.r.Intn(4) == 0 {
+		return kv.Value{}
+	}
+	v := make(kv.Value, 1+run.r.Intn(32))
+	run.r.Read(v)
+	return v
+}
+
+func (run *modelRun) set(ctx context.Context) {
+	k, v := run.key(), run.value()
+	require.NoError(run.t, run.s.Set(ctx, k, v))
+	run.m[string(k)] = string(v)
+}
+
+func (run *modelRun) delete(ctx context.Context) {
+	k := run.key()
+	require.NoError(run.t, run.s.Delete(ctx, k))
+	delete(run.m, string(k))
+}
+
+func (run *modelRun) setIfAbsent(ctx context.Context) {
+	k, v := run.key(), run.value()
+	err := run.s.SetIfAbsent(ctx, k, v)
+	if _, ok := run.m[string(k)]; ok {
+		require.ErrorIs(run.t, err, kv.ErrConflict, "%q", k)
+		return
+	}
+	require.NoError(run.t, err, "%q", k)
+	run.m[string(k)] = string(v)
+}
+
+// version returns the current version of the key and whether to use it
+// for a conditional write, a wrong version is expected to conflict.
+func (run *modelRun) version(ctx context.Context, k kv.Key) (uint64, bool) {
+	v, version, err := run.s.GetWithVersion(ctx, k)
+	want, ok := run.m[string(k)]
+	if !ok {
+		require.ErrorIs(run.t, err, kv.ErrNotFound, "%q", k)
+		return 1 + run.r.Uint64()>>1, false
+	}
+	require.NoError(run.t, err, "%q", k)
+	require.Equal(run.t, want, string(v), "%q", k)
+	require.NotZero(run.t, version, "%q", k)
+
+	if run.r.Intn(2) == 0 {
+		return version + 1, false
+	}
+	return version, true
+}
+
+func (run *modelRun) setIfVersion(ctx context.Context) {
+	k, v := run.key(), run.value()
+	version, match := run.version(ctx, k)
+	err := run.s.SetIfVersion(ctx, k, v, version)
+	if !match {
+		require.ErrorIs(run.t, err, kv.ErrConflict, "%q", k)
+		return
+	}
+	require.NoError(run.t, err, "%q", k)
+	run.m[string(k)] = string(v)
+}
+
+func (run *modelRun) deleteIfVersion(ctx context.Context) {
+	k := run.key()
+	version, match := run.version(ctx, k)
+	err := run.s.DeleteIfVersion(ctx, k, version)
+	if !match {
+		require.ErrorIs(run.t, err, kv.ErrConflict, "%q", k)
+		return
+	}
+	require.NoError(run.t, err, "%q", k)
+	delete(run.m, string(k))
+}
+
+// write applies a batch of distinct keys, the result of a batch writing
+// a key twice is not specified.
+func (run *modelRun) write(ctx context.Context) {
+	var (
+		ops  []kv.Op
+		seen = map[string]bool{}
+	)
+	for i := run.r.Intn(8); i >= 0; i-- {
+		k := run.key()
+		if seen[string(k)] {
+			continue
+		}
+		seen[string(k)] = true
+
+		if run.r.Intn(3) == 0 {
+			ops = append(ops, kv.Op{Type: kv.OpDelete, Key: k})
+		} else {
+			ops = append(ops, kv.Op{Type: kv.OpSet, Key: k, Value: run.value()})
+		}
+	}
+
+	require.NoError(run.t, run.s.Write(ctx, ops))
+	for _, op := range ops {
+		if op.Type == kv.OpDelete {
+			delete(run.m, string(op.Key))
+		} else {
+			run.m[string(op.Key)] = string(op.Value)
+		}
+	}
+}
+
+func (run *modelRun) deleteRange(ctx context.Context) {
+	var (
+		opts kv.ScanOptions
+		n    int64
+		err  error
+	)
+	if run.r.Intn(2) == 0 {
+		opts.Prefix = run.key()
+		n, err = run.s.DeletePrefix(ctx, opts.Prefix)
+	} else {
+		opts.Start, opts.End = run.key(), run.key()
+		n, err = run.s.DeleteRange(ctx, opts.Start, opts.End)
+	}
+	require.NoError(run.t, err)
+
+	keys := run.m.scan(opts)
+	require.Equal(run.t, int64(len(keys)), n, "%+v", opts)
+	for _, k := range keys {
+		delete(run.m, k)
+	}
+}
+
+// txn reads and writes a few keys in a transaction and commits or rolls
+// it back. Nobody else writes meanwhile, so the commit succeeds.
+func (run *modelRun) txn(ctx context.Context) {
+	txn, err := run.s.Begin(ctx)
+	require.NoError(run.t, err)
+
+	// writes holds the pending writes, nil is a delete.
+	writes := map[string]*string{}
+	for i := run.r.Intn(6); i >= 0; i-- {
+		k := run.key()
+		switch run.r.Intn(3) {
+		case 0:
+			v := run.value()
+			require.NoError(run.t, txn.Set(ctx, k, v))
+			value := string(v)
+			writes[string(k)] = &value
+		case 1:
+			require.NoError(run.t, txn.Delete(ctx, k))
+			writes[string(k)] = nil
+		default:
+			want, ok := run.m[string(k)]
+			if w, written := writes[string(k)]; written {
+				ok = w != nil
+				if ok {
+					want = *w
+				}
+			}
+
+			v, err := txn.Get(ctx, k)
+			if !ok {
+				require.ErrorIs(run.t, err, kv.ErrNotFound, "%q", k)
+				continue
+			}
+			require.NoError(run.t, err, "%q", k)
+			require.Equal(run.t, want, string(v), "%q", k)
+		}
+	}
+
+	if run.r.Intn(4) == 0 {
+		require.NoError(run.t, txn.Rollback())
+		return
+	}
+	require.NoError(run.t, txn.Commit(ctx))
+	for k, v := range writes {
+		if v == nil {
+			delete(run.m, k)
+		} else {
+			run.m[k] = *v
+		}
+	}
+}
+
+func (run *modelRun) get(ctx context.Context) {
+	k := run.key()
+	v, err := run.s.Get(ctx, k)
+	want, ok := run.m[string(k)]
+	if !ok {
+		require.ErrorIs(run.t, err, kv.ErrNotFound, "%q", k)
+		return
+	}
+	require.NoError(run.t, err, "%q", k)
+	require.Equal(run.t, want, string(v), "%q", k)
+}
+
+func (run *modelRun) scanOptions() kv.ScanOptions {
+	var opts kv.ScanOptions
+	if run.r.Intn(3) == 0 {
+		opts.Prefix = run.prefix()
+	}
+	if run.r.Intn(3) == 0 {
+		opts.Start = run.key()
+	}
+	if run.r.Intn(3) == 0 {
+		opts.End = run.key()
+	}
+	if run.r.Intn(4) == 0 {
+		opts.After = run.key()
+	}
+	if run.r.Intn(3) == 0 {
+		opts.Limit = 1 + run.r.Intn(5)
+	}
+	opts.Reverse = run.r.Intn(2) == 0
+	return opts
+}
+
+func (run *modelRun) scan(ctx context.Context) {
+	run.checkScan(ctx, run.s.Scan, run.scanOptions(), run.m)
+}
+
+// checkScan runs the scan of a store or a snapshot and compares its
+// keys, their order and values with the model.
+func (run *modelRun) checkScan(ctx context.Context,
+	scan func(context.Context, kv.ScanOptions, kv.ScanHandler) error, opts kv.ScanOptions, m model) {
+	var keys []string
+	err := scan(ctx, opts, func(k kv.Key, v kv.Value) error {
+		want, ok := m[string(k)]
+		if !ok {
+			return fmt.Errorf("scan returned key %q missing in the model", k)
+		}
+		if !bytes.Equal(kv.Value(want), v) {
+			return fmt.Errorf("scan returned a wrong value of %q", k)
+		}
+		keys = append(keys, string(k))
+		return nil
+	})
+	require.NoError(run.t, err, "%+v", opts)
+
+	want := m.scan(opts)
+	if len(want) == 0 {
+		want = nil
+	}
+	require.Equal(run.t, want, keys, "%+v", opts)
+}
+
+func (run *modelRun) count(ctx context.Context) {
+	prefix := run.prefix()
+	n, err := run.s.Count(ctx, prefix)
+	require.NoError(run.t, err)
+	require.Equal(run.t, int64(len(run.m.scan(kv.ScanOptions{Prefix: prefix}))), n, "%q", prefix)
+}
+
+// snapshot checks that a snapshot keeps the state it was taken at while
+// the store changes.
+func (run *modelRun) snapshot(ctx context.Context) {
+	snap, err := run.s.Snapshot(ctx)
+	require.NoError(run.t, err)
+	defer snap.Close()
+	frozen := run.m.clone()
+
+	run.set(ctx)
+	run.delete(ctx)
+	run.write(ctx)
+
+	k := run.key()
+	v, err := snap.Get(ctx, k)
+	if want, ok := frozen[string(k)]; ok {
+		require.NoError(run.t, err, "%q", k)
+		require.Equal(run.t, want, string(v), "%q", k)
+	} else {
+		require.ErrorIs(run.t, err, kv.ErrNotFound, "%q", k)
+	}
+	run.checkScan(ctx, snap.Scan, run.scanOptions(), frozen)
+}
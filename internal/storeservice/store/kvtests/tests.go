@@ -3,30 +3,73 @@ package kvtests
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"kvstore/internal/storeservice/store/kv"
 	"reflect"
 	"runtime"
+	"sort"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
-func RunTests(t *testing.T, db kv.Store) {
+// Factory opens a new empty store. Every test gets a store of its own,
+// which is closed when the test ends.
+type Factory func(testing.TB) kv.Store
+
+// RunTests is the conformance suite every kv.Store has to pass. Besides
+// the cases of each operation it checks the store against a model under
+// random operations and under concurrent readers and writers, the
+// latter are meant to run with -race.
+func RunTests(t *testing.T, newStore Factory) {
 	tests := []func(*testing.T, kv.Store){
 		testSetGet,
 		testGetNotFound,
 		testDelete,
 		testScan,
 		testStopScan,
+		testScanCancel,
+		testScanHandlerWrites,
+		testCanceledContext,
 		testScanPrefixOption,
 		testScanLimitOption,
+		testSetWithTTL,
+		testScanRangeOptions,
+		testWrite,
+		testWriteAtomic,
+		testConditionalWrites,
+		testCompareAndSwapConcurrent,
+		testTxnCommit,
+		testTxnTTL,
+		testTxnRollback,
+		testTxnIsolation,
+		testTxnConflict,
+		testSnapshot,
+		testCount,
+		testStats,
+		testDeletePrefix,
+		testDeleteRange,
+		testWatch,
+		testWatchResume,
+		testBackupRestore,
+		testIncrementalBackup,
+		testModel,
+		testConcurrentReadersWriters,
+		testConcurrentTxns,
 	}
 
 	for _, test := range tests {
 		testName := runtime.FuncForPC(reflect.ValueOf(test).Pointer()).Name()
 		t.Run(testName, func(t *testing.T) {
-			test(t, db)
+			s := newStore(t)
+			t.Cleanup(func() {
+				require.NoError(t, s.Close())
+			})
+			test(t, s)
 		})
 	}
 }
@@ -80,13 +123,16 @@ func testScan(t *testing.T, s kv.Store) {
 	}
 
 	r := map[string]kv.Value{}
+	var keys []string
 	err := s.Scan(ctx, kv.ScanOptions{},
 		func(k kv.Key, v kv.Value) error {
 			r[string(k)] = v
+			keys = append(keys, string(k))
 			return nil
 		})
 	require.NoError(t, err)
 	require.Equal(t, m, r)
+	require.True(t, sort.StringsAreSorted(keys), "scan must return keys in lexicographic order")
 }
 
 func testStopScan(t *testing.T, s kv.Store) {
@@ -115,6 +161,128 @@ func testStopScan(t *testing.T, s kv.Store) {
 	require.Equal(t, read, counter)
 }
 
+func testScanCancel(t *testing.T, s kv.Store) {
+	const count = 100
+	const read = 10
+
+	for i := 0; i < count; i++ {
+		key := kv.Key(fmt.Sprintf("scan-cancel/%03d", i))
+		require.NoError(t, s.Set(context.Background(), key, kv.Value("value")))
+	}
+
+	scan := func(scan func(context.Context, kv.ScanOptions, kv.ScanHandler) error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		counter := 0
+		err := scan(ctx, kv.ScanOptions{Prefix: kv.Key("scan-cancel/")},
+			func(k kv.Key, v kv.Value) error {
+				counter++
+				if counter == read {
+					cancel()
+				}
+				return nil
+			})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, read, counter)
+	}
+
+	scan(s.Scan)
+
+	snap, err := s.Snapshot(context.Background())
+	require.NoError(t, err)
+	defer snap.Close()
+	scan(snap.Scan)
+}
+
+func testScanHandlerWrites(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	const count = 10
+
+	for i := 0; i < count; i++ {
+		key := kv.Key(fmt.Sprintf("scan-writes/%d", i))
+		require.NoError(t, s.Set(ctx, key, kv.Value("value")))
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key("scan-writes/")},
+			func(k kv.Key, v kv.Value) error {
+				copied := append(kv.Key("scan-writes-copy/"), k...)
+				if err := s.Set(ctx, copied, v); err != nil {
+					return err
+				}
+				_, err := s.Get(ctx, k)
+				return err
+			})
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("scan handler writing to the store deadlocked")
+	}
+
+	n, err := s.Count(ctx, kv.Key("scan-writes-copy/"))
+	require.NoError(t, err)
+	require.Equal(t, int64(count), n)
+}
+
+func testCanceledContext(t *testing.T, s kv.Store) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	key := kv.Key("canceled/key")
+
+	require.NoError(t, s.Set(context.Background(), key, kv.Value("old")))
+
+	err := s.Set(ctx, key, kv.Value("new"))
+	require.ErrorIs(t, err, context.Canceled)
+	err = s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: key, Value: kv.Value("new")},
+		{Type: kv.OpSet, Key: kv.Key("canceled/other"), Value: kv.Value("new")},
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	err = s.Delete(ctx, key)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = s.DeletePrefix(ctx, kv.Key("canceled/"))
+	require.ErrorIs(t, err, context.Canceled)
+
+	v, err := s.Get(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("old"), v)
+	_, err = s.Get(context.Background(), kv.Key("canceled/other"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+
+	_, err = s.Get(ctx, key)
+	require.ErrorIs(t, err, context.Canceled)
+	err = s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key("canceled/")},
+		func(k kv.Key, v kv.Value) error {
+			t.Fatalf("scan with a canceled context read %q", k)
+			return nil
+		})
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = s.Count(ctx, kv.Key("canceled/"))
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = s.Stats(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = s.Begin(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = s.Snapshot(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	txn, err := s.Begin(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, txn.Set(context.Background(), key, kv.Value("txn")))
+	err = txn.Commit(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.ErrorIs(t, txn.Commit(context.Background()), kv.ErrTxnClosed)
+
+	v, err = s.Get(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("old"), v)
+}
+
 func testScanPrefixOption(t *testing.T, s kv.Store) {
 	ctx := context.Background()
 	const count = 100
@@ -134,13 +302,17 @@ func testScanPrefixOption(t *testing.T, s kv.Store) {
 		require.NoError(t, err)
 	}
 
+	var keys []string
 	err := s.Scan(ctx, kv.ScanOptions{
 		Prefix: []byte(scanPrefix),
 	}, func(k kv.Key, v kv.Value) error {
 		require.True(t, bytes.HasPrefix(k, []byte(scanPrefix)))
+		keys = append(keys, string(k))
 		return nil
 	})
 	require.NoError(t, err)
+	require.Len(t, keys, count/len(prefixes))
+	require.True(t, sort.StringsAreSorted(keys), "scan must return keys in lexicographic order")
 }
 
 func testScanLimitOption(t *testing.T, s kv.Store) {
@@ -156,13 +328,758 @@ func testScanLimitOption(t *testing.T, s kv.Store) {
 		require.NoError(t, err)
 	}
 
-	var counter int
+	var all []string
 	err := s.Scan(ctx, kv.ScanOptions{
-		Limit: read,
+		Prefix: kv.Key("key-"),
 	}, func(k kv.Key, v kv.Value) error {
-		counter++
+		all = append(all, string(k))
 		return nil
 	})
 	require.NoError(t, err)
-	require.Equal(t, read, counter)
+
+	var keys []string
+	err = s.Scan(ctx, kv.ScanOptions{
+		Prefix: kv.Key("key-"),
+		Limit:  read,
+	}, func(k kv.Key, v kv.Value) error {
+		keys = append(keys, string(k))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, all[:read], keys, "limit must keep the first keys in order")
+}
+
+func testSetWithTTL(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	const ttl = 2 * time.Second
+
+	err := s.SetWithTTL(ctx, kv.Key("ttl/expiring"), kv.Value("val"), ttl)
+	require.NoError(t, err)
+	err = s.SetWithTTL(ctx, kv.Key("ttl/persistent"), kv.Value("val"), 0)
+	require.NoError(t, err)
+
+	val, err := s.Get(ctx, kv.Key("ttl/expiring"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("val"), val)
+
+	require.Eventually(t, func() bool {
+		_, err := s.Get(ctx, kv.Key("ttl/expiring"))
+		return errors.Is(err, kv.ErrNotFound)
+	}, 2*ttl, 100*time.Millisecond)
+
+	var keys []string
+	err = s.Scan(ctx, kv.ScanOptions{
+		Prefix: kv.Key("ttl/"),
+	}, func(k kv.Key, v kv.Value) error {
+		keys = append(keys, string(k))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"ttl/persistent"}, keys)
+}
+
+func testScanRangeOptions(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	const count = 20
+
+	for i := 0; i < count; i++ {
+		key := fmt.Sprintf("%s/%02d", "range", i)
+		err := s.Set(ctx, kv.Key(key), kv.Value("val"))
+		require.NoError(t, err)
+	}
+	// Neighbours just outside of the prefix must never leak into results.
+	err := s.Set(ctx, kv.Key("range"), kv.Value("val"))
+	require.NoError(t, err)
+	err = s.Set(ctx, kv.Key("range0"), kv.Value("val"))
+	require.NoError(t, err)
+
+	scan := func(opts kv.ScanOptions) []string {
+		var keys []string
+		err := s.Scan(ctx, opts, func(k kv.Key, v kv.Value) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+		require.NoError(t, err)
+		return keys
+	}
+
+	cases := []struct {
+		name string
+		opts kv.ScanOptions
+		want []string
+	}{
+		{
+			name: "start_end",
+			opts: kv.ScanOptions{Start: kv.Key("range/05"), End: kv.Key("range/08")},
+			want: []string{"range/05", "range/06", "range/07"},
+		},
+		{
+			name: "prefix_start",
+			opts: kv.ScanOptions{Prefix: kv.Key("range/"), Start: kv.Key("range/17")},
+			want: []string{"range/17", "range/18", "range/19"},
+		},
+		{
+			name: "reverse_prefix_limit",
+			opts: kv.ScanOptions{Prefix: kv.Key("range/"), Reverse: true, Limit: 3},
+			want: []string{"range/19", "range/18", "range/17"},
+		},
+		{
+			name: "reverse_start_end",
+			opts: kv.ScanOptions{Start: kv.Key("range/05"), End: kv.Key("range/08"), Reverse: true},
+			want: []string{"range/07", "range/06", "range/05"},
+		},
+		{
+			name: "reverse_prefix_end",
+			opts: kv.ScanOptions{Prefix: kv.Key("range/"), End: kv.Key("range/02"), Reverse: true},
+			want: []string{"range/01", "range/00"},
+		},
+		{
+			name: "empty_range",
+			opts: kv.ScanOptions{Start: kv.Key("range/08"), End: kv.Key("range/05")},
+			want: nil,
+		},
+		{
+			name: "prefix_after",
+			opts: kv.ScanOptions{Prefix: kv.Key("range/"), After: kv.Key("range/16"), Limit: 2},
+			want: []string{"range/17", "range/18"},
+		},
+		{
+			name: "reverse_prefix_after",
+			opts: kv.ScanOptions{Prefix: kv.Key("range/"), After: kv.Key("range/02"), Reverse: true},
+			want: []string{"range/01", "range/00"},
+		},
+		{
+			name: "after_missing_key",
+			opts: kv.ScanOptions{Prefix: kv.Key("range/"), After: kv.Key("range/185")},
+			want: []string{"range/19"},
+		},
+		{
+			name: "after_before_start",
+			opts: kv.ScanOptions{Start: kv.Key("range/05"), End: kv.Key("range/07"), After: kv.Key("range/01")},
+			want: []string{"range/05", "range/06"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.want, scan(c.opts))
+		})
+	}
+}
+
+func testCount(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	for _, key := range []string{"count/a/1", "count/a/2", "count/b/1", "count/deleted"} {
+		err := s.Set(ctx, kv.Key(key), kv.Value("val"))
+		require.NoError(t, err)
+	}
+	err := s.Delete(ctx, kv.Key("count/deleted"))
+	require.NoError(t, err)
+
+	for prefix, want := range map[string]int64{
+		"count/":   3,
+		"count/a/": 2,
+		"count/c/": 0,
+	} {
+		count, err := s.Count(ctx, kv.Key(prefix))
+		require.NoError(t, err)
+		require.Equal(t, want, count, prefix)
+	}
+
+	all, err := s.Count(ctx, nil)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, all, int64(3))
+}
+
+func testStats(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	before, err := s.Stats(ctx)
+	require.NoError(t, err)
+
+	err = s.Set(ctx, kv.Key("stats/key"), kv.Value("value"))
+	require.NoError(t, err)
+
+	after, err := s.Stats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, before.Keys+1, after.Keys)
+	require.Equal(t, before.LogicalBytes+int64(len("stats/key")+len("value")), after.LogicalBytes)
+	require.GreaterOrEqual(t, after.DiskBytes, after.LSMBytes+after.VlogBytes)
+}
+
+func testDeletePrefix(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	// More keys than fit in a single batch of any backend.
+	const count = 25000
+	ops := make([]kv.Op, 0, 1000)
+	for i := 0; i < count; i++ {
+		key := kv.Key(fmt.Sprintf("dp/del/%05d", i))
+		ops = append(ops, kv.Op{Type: kv.OpSet, Key: key, Value: kv.Value("val")})
+		if len(ops) == cap(ops) {
+			require.NoError(t, s.Write(ctx, ops))
+			ops = ops[:0]
+		}
+	}
+	require.NoError(t, s.Set(ctx, kv.Key("dp/keep"), kv.Value("val")))
+	require.NoError(t, s.Set(ctx, kv.Key("dp/del"), kv.Value("val")))
+
+	deleted, err := s.DeletePrefix(ctx, kv.Key("dp/del/"))
+	require.NoError(t, err)
+	require.Equal(t, int64(count), deleted)
+
+	left, err := s.Count(ctx, kv.Key("dp/"))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), left)
+
+	deleted, err = s.DeletePrefix(ctx, kv.Key("dp/del/"))
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+}
+
+func testDeleteRange(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	for i := 0; i < 10; i++ {
+		err := s.Set(ctx, kv.Key(fmt.Sprintf("dr/%02d", i)), kv.Value("val"))
+		require.NoError(t, err)
+	}
+
+	counted, err := s.CountRange(ctx, kv.Key("dr/03"), kv.Key("dr/07"))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), counted)
+	counted, err = s.CountRange(ctx, kv.Key("dr/08"), nil)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, counted, int64(2))
+
+	deleted, err := s.DeleteRange(ctx, kv.Key("dr/03"), kv.Key("dr/07"))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), deleted)
+
+	var keys []string
+	err = s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key("dr/")}, func(k kv.Key, v kv.Value) error {
+		keys = append(keys, string(k))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"dr/00", "dr/01", "dr/02", "dr/07", "dr/08", "dr/09"}, keys)
+
+	counted, err = s.CountRange(ctx, kv.Key("dr/"), kv.Key("dr/99"))
+	require.NoError(t, err)
+	require.Equal(t, int64(6), counted)
+
+	deleted, err = s.DeleteRange(ctx, kv.Key("dr/08"), kv.Key("dr/05"))
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+}
+
+func testWrite(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	err := s.Set(ctx, kv.Key("batch/old"), kv.Value("old"))
+	require.NoError(t, err)
+
+	err = s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("batch/a"), Value: kv.Value("a")},
+		{Type: kv.OpSet, Key: kv.Key("batch/b"), Value: kv.Value("b")},
+		{Type: kv.OpDelete, Key: kv.Key("batch/old")},
+		{Type: kv.OpSet, Key: kv.Key("batch/a"), Value: kv.Value("a2")},
+	})
+	require.NoError(t, err)
+
+	val, err := s.Get(ctx, kv.Key("batch/a"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("a2"), val)
+
+	val, err = s.Get(ctx, kv.Key("batch/b"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("b"), val)
+
+	_, err = s.Get(ctx, kv.Key("batch/old"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+}
+
+func testWriteAtomic(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	err := s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("batch/atomic"), Value: kv.Value("val")},
+		{Type: kv.OpType(-1), Key: kv.Key("batch/invalid")},
+	})
+	require.ErrorIs(t, err, kv.ErrUnknownOp)
+
+	_, err = s.Get(ctx, kv.Key("batch/atomic"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+}
+
+func testConditionalWrites(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	key := kv.Key("cas/key")
+
+	err := s.SetIfVersion(ctx, key, kv.Value("val"), 1)
+	require.ErrorIs(t, err, kv.ErrConflict)
+	err = s.DeleteIfVersion(ctx, key, 1)
+	require.ErrorIs(t, err, kv.ErrConflict)
+
+	err = s.SetIfAbsent(ctx, key, kv.Value("v1"))
+	require.NoError(t, err)
+	err = s.SetIfAbsent(ctx, key, kv.Value("v1"))
+	require.ErrorIs(t, err, kv.ErrConflict)
+
+	val, v1, err := s.GetWithVersion(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("v1"), val)
+	require.NotZero(t, v1)
+
+	err = s.SetIfVersion(ctx, key, kv.Value("v2"), v1)
+	require.NoError(t, err)
+	err = s.SetIfVersion(ctx, key, kv.Value("v3"), v1)
+	require.ErrorIs(t, err, kv.ErrConflict)
+
+	val, v2, err := s.GetWithVersion(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("v2"), val)
+	require.Greater(t, v2, v1)
+
+	err = s.Set(ctx, key, kv.Value("v4"))
+	require.NoError(t, err)
+	_, v4, err := s.GetWithVersion(ctx, key)
+	require.NoError(t, err)
+	require.Greater(t, v4, v2)
+
+	err = s.DeleteIfVersion(ctx, key, v2)
+	require.ErrorIs(t, err, kv.ErrConflict)
+	err = s.DeleteIfVersion(ctx, key, v4)
+	require.NoError(t, err)
+
+	_, err = s.Get(ctx, key)
+	require.ErrorIs(t, err, kv.ErrNotFound)
+}
+
+func testCompareAndSwapConcurrent(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	const workers = 8
+	const increments = 20
+	key := kv.Key("cas/counter")
+
+	err := s.Set(ctx, key, kv.Value("0"))
+	require.NoError(t, err)
+
+	increment := func() error {
+		for {
+			val, version, err := s.GetWithVersion(ctx, key)
+			if err != nil {
+				return err
+			}
+			n, err := strconv.Atoi(string(val))
+			if err != nil {
+				return err
+			}
+
+			err = s.SetIfVersion(ctx, key, kv.Value(strconv.Itoa(n+1)), version)
+			if errors.Is(err, kv.ErrConflict) {
+				continue
+			}
+			return err
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*increments)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				errs <- increment()
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	val, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, kv.Value(strconv.Itoa(workers*increments)), val)
+}
+
+func testTxnCommit(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	err := s.Set(ctx, kv.Key("txn/commit/old"), kv.Value("old"))
+	require.NoError(t, err)
+
+	txn, err := s.Begin(ctx)
+	require.NoError(t, err)
+
+	err = txn.Set(ctx, kv.Key("txn/commit/new"), kv.Value("new"))
+	require.NoError(t, err)
+	err = txn.Delete(ctx, kv.Key("txn/commit/old"))
+	require.NoError(t, err)
+
+	// The transaction observes its own writes before commit...
+	val, err := txn.Get(ctx, kv.Key("txn/commit/new"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("new"), val)
+	_, err = txn.Get(ctx, kv.Key("txn/commit/old"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+
+	// ...while nobody else does.
+	_, err = s.Get(ctx, kv.Key("txn/commit/new"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	val, err = s.Get(ctx, kv.Key("txn/commit/new"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("new"), val)
+	_, err = s.Get(ctx, kv.Key("txn/commit/old"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+
+	err = txn.Set(ctx, kv.Key("txn/commit/new"), kv.Value("again"))
+	require.ErrorIs(t, err, kv.ErrTxnClosed)
+	require.NoError(t, txn.Rollback())
+}
+
+func testTxnTTL(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	const ttl = 2 * time.Second
+
+	txn, err := s.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, txn.SetWithTTL(ctx, kv.Key("txn/ttl/expiring"), kv.Value("val"), ttl))
+	require.NoError(t, txn.SetWithTTL(ctx, kv.Key("txn/ttl/persistent"), kv.Value("val"), 0))
+	require.NoError(t, txn.Commit(ctx))
+
+	val, err := s.Get(ctx, kv.Key("txn/ttl/expiring"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("val"), val)
+
+	require.Eventually(t, func() bool {
+		_, err := s.Get(ctx, kv.Key("txn/ttl/expiring"))
+		return errors.Is(err, kv.ErrNotFound)
+	}, 2*ttl, 100*time.Millisecond)
+	_, err = s.Get(ctx, kv.Key("txn/ttl/persistent"))
+	require.NoError(t, err)
+}
+
+func testTxnRollback(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	txn, err := s.Begin(ctx)
+	require.NoError(t, err)
+
+	err = txn.Set(ctx, kv.Key("txn/rollback"), kv.Value("val"))
+	require.NoError(t, err)
+	require.NoError(t, txn.Rollback())
+
+	_, err = s.Get(ctx, kv.Key("txn/rollback"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+
+	err = txn.Commit(ctx)
+	require.ErrorIs(t, err, kv.ErrTxnClosed)
+}
+
+func testTxnIsolation(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	err := s.Set(ctx, kv.Key("txn/isolation"), kv.Value("before"))
+	require.NoError(t, err)
+
+	txn, err := s.Begin(ctx)
+	require.NoError(t, err)
+	defer txn.Rollback()
+
+	err = s.Set(ctx, kv.Key("txn/isolation"), kv.Value("after"))
+	require.NoError(t, err)
+
+	val, err := txn.Get(ctx, kv.Key("txn/isolation"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("before"), val)
+}
+
+func testTxnConflict(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+	from, to := kv.Key("txn/balance/from"), kv.Key("txn/balance/to")
+
+	err := s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: from, Value: kv.Value("100")},
+		{Type: kv.OpSet, Key: to, Value: kv.Value("0")},
+	})
+	require.NoError(t, err)
+
+	transfer := func(txn kv.Txn, amount int) {
+		fromVal, err := txn.Get(ctx, from)
+		require.NoError(t, err)
+		toVal, err := txn.Get(ctx, to)
+		require.NoError(t, err)
+
+		fromBalance, err := strconv.Atoi(string(fromVal))
+		require.NoError(t, err)
+		toBalance, err := strconv.Atoi(string(toVal))
+		require.NoError(t, err)
+
+		err = txn.Set(ctx, from, kv.Value(strconv.Itoa(fromBalance-amount)))
+		require.NoError(t, err)
+		err = txn.Set(ctx, to, kv.Value(strconv.Itoa(toBalance+amount)))
+		require.NoError(t, err)
+	}
+
+	first, err := s.Begin(ctx)
+	require.NoError(t, err)
+	second, err := s.Begin(ctx)
+	require.NoError(t, err)
+
+	transfer(first, 10)
+	transfer(second, 20)
+
+	require.NoError(t, first.Commit(ctx))
+	require.ErrorIs(t, second.Commit(ctx), kv.ErrConflict)
+
+	val, err := s.Get(ctx, from)
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("90"), val)
+	val, err = s.Get(ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("10"), val)
+}
+
+func testSnapshot(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	err := s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("snapshot/a"), Value: kv.Value("a1")},
+		{Type: kv.OpSet, Key: kv.Key("snapshot/b"), Value: kv.Value("b1")},
+	})
+	require.NoError(t, err)
+
+	snap, err := s.Snapshot(ctx)
+	require.NoError(t, err)
+	defer snap.Close()
+
+	err = s.Set(ctx, kv.Key("snapshot/a"), kv.Value("a2"))
+	require.NoError(t, err)
+	err = s.Delete(ctx, kv.Key("snapshot/b"))
+	require.NoError(t, err)
+	err = s.Set(ctx, kv.Key("snapshot/c"), kv.Value("c2"))
+	require.NoError(t, err)
+
+	val, err := snap.Get(ctx, kv.Key("snapshot/a"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("a1"), val)
+	val, err = snap.Get(ctx, kv.Key("snapshot/b"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("b1"), val)
+	_, err = snap.Get(ctx, kv.Key("snapshot/c"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+
+	r := map[string]string{}
+	err = snap.Scan(ctx, kv.ScanOptions{
+		Prefix: kv.Key("snapshot/"),
+	}, func(k kv.Key, v kv.Value) error {
+		r[string(k)] = string(v)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"snapshot/a": "a1",
+		"snapshot/b": "b1",
+	}, r)
+
+	// The store itself moves on.
+	val, err = s.Get(ctx, kv.Key("snapshot/a"))
+	require.NoError(t, err)
+	require.Equal(t, kv.Value("a2"), val)
+}
+
+// watchEvents runs Watch in background and passes its events on.
+func watchEvents(ctx context.Context, s kv.Store, prefix string, from uint64) (<-chan kv.Event, <-chan error) {
+	var (
+		events = make(chan kv.Event, 100)
+		done   = make(chan error, 1)
+	)
+	go func() {
+		done <- s.Watch(ctx, kv.Key(prefix), from, func(e kv.Event) error {
+			events <- e
+			return nil
+		})
+	}()
+	return events, done
+}
+
+func nextEvent(t *testing.T, events <-chan kv.Event) kv.Event {
+	t.Helper()
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event")
+		return kv.Event{}
+	}
+}
+
+func requireEvent(t *testing.T, e kv.Event, typ kv.EventType, key, value string) {
+	t.Helper()
+	require.Equal(t, typ, e.Type, key)
+	require.Equal(t, key, string(e.Key))
+	if typ == kv.EventPut {
+		require.Equal(t, value, string(e.Value), key)
+	} else {
+		require.Nil(t, e.Value, key)
+	}
+}
+
+func testWatch(t *testing.T, s kv.Store) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Another write first keeps the version of watch/a above one, so
+	// there is a version before it to replay from.
+	require.NoError(t, s.Set(ctx, kv.Key("other/first"), kv.Value("first")))
+	require.NoError(t, s.Set(ctx, kv.Key("watch/a"), kv.Value("a1")))
+	_, version, err := s.GetWithVersion(ctx, kv.Key("watch/a"))
+	require.NoError(t, err)
+
+	// Replaying watch/a shows the watcher is registered.
+	events, done := watchEvents(ctx, s, "watch/", version-1)
+	e := nextEvent(t, events)
+	requireEvent(t, e, kv.EventPut, "watch/a", "a1")
+	require.Equal(t, version, e.Version)
+
+	require.NoError(t, s.Set(ctx, kv.Key("other/a"), kv.Value("ignored")))
+	require.NoError(t, s.Set(ctx, kv.Key("watch/b"), kv.Value("b1")))
+	require.NoError(t, s.Delete(ctx, kv.Key("watch/a")))
+	require.NoError(t, s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("watch/c"), Value: kv.Value("c1")},
+		{Type: kv.OpSet, Key: kv.Key("watch/d"), Value: kv.Value("d1")},
+	}))
+
+	put := nextEvent(t, events)
+	requireEvent(t, put, kv.EventPut, "watch/b", "b1")
+	require.Greater(t, put.Version, version)
+
+	del := nextEvent(t, events)
+	requireEvent(t, del, kv.EventDelete, "watch/a", "")
+	require.Greater(t, del.Version, put.Version)
+
+	// The operations of a batch share the version and may come in any
+	// order.
+	batch := []kv.Event{nextEvent(t, events), nextEvent(t, events)}
+	sort.Slice(batch, func(i, j int) bool { return string(batch[i].Key) < string(batch[j].Key) })
+	requireEvent(t, batch[0], kv.EventPut, "watch/c", "c1")
+	requireEvent(t, batch[1], kv.EventPut, "watch/d", "d1")
+	require.Greater(t, batch[0].Version, del.Version)
+	require.Equal(t, batch[0].Version, batch[1].Version)
+
+	cancel()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch did not stop")
+	}
+	require.Empty(t, events)
+}
+
+func testWatchResume(t *testing.T, s kv.Store) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, s.Set(ctx, kv.Key("resume/a"), kv.Value("a1")))
+	_, version, err := s.GetWithVersion(ctx, kv.Key("resume/a"))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Set(ctx, kv.Key("resume/b"), kv.Value("b1")))
+	require.NoError(t, s.Set(ctx, kv.Key("resume/a"), kv.Value("a2")))
+	require.NoError(t, s.Set(ctx, kv.Key("resume/c"), kv.Value("c1")))
+	require.NoError(t, s.Delete(ctx, kv.Key("resume/c")))
+
+	// Keys written after the version are replayed with their current
+	// values in key order, deleted keys are not.
+	events, _ := watchEvents(ctx, s, "resume/", version)
+	requireEvent(t, nextEvent(t, events), kv.EventPut, "resume/a", "a2")
+	requireEvent(t, nextEvent(t, events), kv.EventPut, "resume/b", "b1")
+
+	require.NoError(t, s.Set(ctx, kv.Key("resume/d"), kv.Value("d1")))
+	requireEvent(t, nextEvent(t, events), kv.EventPut, "resume/d", "d1")
+}
+
+func requireValues(t *testing.T, s kv.Store, want map[string]string) {
+	t.Helper()
+	for k, v := range want {
+		val, err := s.Get(context.Background(), kv.Key(k))
+		require.NoError(t, err, k)
+		require.Equal(t, kv.Value(v), val, k)
+	}
+}
+
+func testBackupRestore(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	require.NoError(t, s.Set(ctx, kv.Key("backup/a"), kv.Value("a1")))
+	require.NoError(t, s.Set(ctx, kv.Key("backup/b"), kv.Value("b1")))
+
+	var buf bytes.Buffer
+	_, err := s.Backup(ctx, &buf, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Set(ctx, kv.Key("backup/a"), kv.Value("a2")))
+	require.NoError(t, s.Delete(ctx, kv.Key("backup/b")))
+	require.NoError(t, s.Set(ctx, kv.Key("backup/c"), kv.Value("c2")))
+
+	_, before, err := s.GetWithVersion(ctx, kv.Key("backup/a"))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Restore(ctx, &buf))
+
+	// Keys missing from the backup are left alone.
+	requireValues(t, s, map[string]string{
+		"backup/a": "a1",
+		"backup/b": "b1",
+		"backup/c": "c2",
+	})
+
+	// Restored keys get new versions, so older versions do not match.
+	_, after, err := s.GetWithVersion(ctx, kv.Key("backup/a"))
+	require.NoError(t, err)
+	require.NotEqual(t, before, after)
+	require.ErrorIs(t, s.SetIfVersion(ctx, kv.Key("backup/a"), kv.Value("a3"), before), kv.ErrConflict)
+}
+
+func testIncrementalBackup(t *testing.T, s kv.Store) {
+	ctx := context.Background()
+
+	require.NoError(t, s.Set(ctx, kv.Key("incremental/a"), kv.Value("a1")))
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.Set(ctx, kv.Key(fmt.Sprintf("incremental/full/%d", i)), kv.Value("full")))
+	}
+
+	var full bytes.Buffer
+	since, err := s.Backup(ctx, &full, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Set(ctx, kv.Key("incremental/b"), kv.Value("b1")))
+
+	var inc bytes.Buffer
+	next, err := s.Backup(ctx, &inc, since)
+	require.NoError(t, err)
+	require.Greater(t, next, since)
+	require.Less(t, inc.Len(), full.Len())
+
+	require.NoError(t, s.Set(ctx, kv.Key("incremental/a"), kv.Value("a2")))
+	require.NoError(t, s.Delete(ctx, kv.Key("incremental/b")))
+
+	require.NoError(t, s.Restore(ctx, &inc))
+
+	// Only keys written after the full backup are restored.
+	requireValues(t, s, map[string]string{
+		"incremental/a": "a2",
+		"incremental/b": "b1",
+	})
 }
@@ -0,0 +1,88 @@
+package mapkv
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvdump"
+	"time"
+)
+
+// Backup writes a kvdump of clones of the shards, so writers are not
+// blocked while the dump is written. Deleted keys are not tracked, an
+// incremental backup only carries keys written after since.
+func (s *Store) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	trees, version := s.clone()
+
+	dw, err := kvdump.NewWriter(w)
+	if err != nil {
+		return 0, err
+	}
+
+	err = mergeTrees(trees, kv.ScanOptions{}, time.Now(), func(e entry) error {
+		if e.version <= since {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return dw.Write(kvdump.Entry{
+			Key:       kv.Key(e.key),
+			Value:     e.value,
+			Version:   e.version,
+			ExpiresAt: e.expiresAt,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := dw.Close(); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// Restore only accepts kvdump dumps. Restored entries get new versions.
+func (s *Store) Restore(ctx context.Context, r io.Reader) error {
+	br := bufio.NewReader(r)
+	if !kvdump.IsDump(br) {
+		return kv.ErrUnsupportedBackup
+	}
+
+	dr, err := kvdump.NewReader(br)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		e, err := dr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !e.ExpiresAt.IsZero() && !time.Now().Before(e.ExpiresAt) {
+			continue
+		}
+		if err := s.restoreEntry(entry{key: string(e.Key), value: e.Value, expiresAt: e.ExpiresAt}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Store) restoreEntry(e entry) error {
+	sh := s.shardOf(e.key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return s.put(e)
+}
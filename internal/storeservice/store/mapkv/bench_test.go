@@ -0,0 +1,105 @@
+package mapkv
+
+import (
+	"context"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"sync/atomic"
+	"testing"
+)
+
+const benchKeys = 100000
+
+// benchShards compares a single shard, which is a store guarded by one
+// lock, with the default number of shards.
+func benchShards(b *testing.B, f func(*testing.B, *Store)) {
+	for _, shards := range []int{1, defaultShards} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			s := newSharded(b, shards)
+			defer s.Close()
+			f(b, s)
+		})
+	}
+}
+
+func benchKey(i uint64) kv.Key {
+	return kv.Key(fmt.Sprintf("bench/%08d", i%benchKeys))
+}
+
+func fillBench(b *testing.B, s *Store) {
+	value := make(kv.Value, 100)
+	for i := uint64(0); i < benchKeys; i++ {
+		if err := s.Set(context.Background(), benchKey(i), value); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParallelSet(b *testing.B) {
+	benchShards(b, func(b *testing.B, s *Store) {
+		var (
+			ctx   = context.Background()
+			value = make(kv.Value, 100)
+			n     atomic.Uint64
+		)
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if err := s.Set(ctx, benchKey(n.Add(1)*7919), value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
+
+func BenchmarkParallelGet(b *testing.B) {
+	benchShards(b, func(b *testing.B, s *Store) {
+		var (
+			ctx = context.Background()
+			n   atomic.Uint64
+		)
+		fillBench(b, s)
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := s.Get(ctx, benchKey(n.Add(1)*7919)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
+
+// BenchmarkParallelMixed runs nine writes for every scan of a hundred
+// keys, the scans used to block every writer for their whole duration.
+func BenchmarkParallelMixed(b *testing.B) {
+	benchShards(b, func(b *testing.B, s *Store) {
+		var (
+			ctx   = context.Background()
+			value = make(kv.Value, 100)
+			n     atomic.Uint64
+		)
+		fillBench(b, s)
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				i := n.Add(1)
+				if i%10 == 0 {
+					opts := kv.ScanOptions{Start: benchKey(i * 7919), Limit: 100}
+					err := s.Scan(ctx, opts, func(kv.Key, kv.Value) error { return nil })
+					if err != nil {
+						b.Fatal(err)
+					}
+					continue
+				}
+				if err := s.Set(ctx, benchKey(i*7919), value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
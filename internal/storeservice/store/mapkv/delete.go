@@ -0,0 +1,82 @@
+package mapkv
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+)
+
+// deleteBatchSize is the number of deletes logged as one record, so a
+// huge deletion does not make a record too large to read back.
+const deleteBatchSize = 10000
+
+func (s *Store) DeletePrefix(ctx context.Context, prefix kv.Key) (int64, error) {
+	return s.deleteRange(ctx, kv.ScanOptions{Prefix: prefix})
+}
+
+func (s *Store) DeleteRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	return s.deleteRange(ctx, kv.ScanOptions{Start: start, End: end})
+}
+
+// deleteRange removes the keys one shard and one batch at a time, only
+// the shard of the batch is locked while it is committed. Readers may
+// see a part of the keys deleted. Expired keys are removed too, but are
+// not counted. On failure it returns the number of keys removed so far.
+func (s *Store) deleteRange(ctx context.Context, opts kv.ScanOptions) (int64, error) {
+	lower, upper := opts.Bounds()
+
+	var count int64
+	for _, sh := range s.shards {
+		for more := true; more; {
+			if err := ctx.Err(); err != nil {
+				return count, err
+			}
+
+			var (
+				n   int64
+				err error
+			)
+			n, more, err = s.deleteBatch(sh, lower, upper)
+			count += n
+			if err != nil {
+				return count, err
+			}
+		}
+	}
+	return count, nil
+}
+
+// deleteBatch removes at most deleteBatchSize keys in [lower, upper)
+// from the shard and returns the number of live keys it removed. more
+// tells whether the shard has keys of the range left.
+func (s *Store) deleteBatch(sh *shard, lower, upper kv.Key) (count int64, more bool, err error) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	var (
+		now     = time.Now()
+		changes []change
+	)
+	sh.tree.AscendGreaterOrEqual(entry{key: string(lower)}, func(e entry) bool {
+		if upper != nil && e.key >= string(upper) {
+			return false
+		}
+		if len(changes) == deleteBatchSize {
+			more = true
+			return false
+		}
+		if !e.expired(now) {
+			count++
+		}
+		changes = append(changes, change{deleted: true, entry: entry{key: e.key}})
+		return true
+	})
+	if len(changes) == 0 {
+		return 0, false, nil
+	}
+
+	if err := s.commit(changes); err != nil {
+		return 0, false, err
+	}
+	return count, more, nil
+}
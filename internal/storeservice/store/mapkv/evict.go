@@ -0,0 +1,245 @@
+package mapkv
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ErrTooLarge is returned for a write which alone exceeds the budget of
+// the store.
+var ErrTooLarge = errors.New("write exceeds the memory budget")
+
+// entryOverhead estimates the memory a key takes besides its key and
+// value: the entry, its slot in the tree and its eviction bookkeeping.
+const entryOverhead = 128
+
+func entrySize(e entry) int64 {
+	return int64(len(e.key)+len(e.value)) + entryOverhead
+}
+
+// budget bounds the size of the store. The usage is shared by all
+// shards and updated with their locks held.
+type budget struct {
+	maxBytes int64
+	maxKeys  int64
+
+	bytes   atomic.Int64
+	keys    atomic.Int64
+	metrics *evictionMetrics
+}
+
+func newBudget(maxBytes, maxKeys int64) *budget {
+	b := &budget{
+		maxBytes: maxBytes,
+		maxKeys:  maxKeys,
+	}
+	b.metrics = newEvictionMetrics(b)
+	return b
+}
+
+func (b *budget) exceeded() bool {
+	return b.maxBytes > 0 && b.bytes.Load() > b.maxBytes ||
+		b.maxKeys > 0 && b.keys.Load() > b.maxKeys
+}
+
+// check rejects changes which cannot fit even if every other key is
+// evicted.
+func (b *budget) check(changes []change) error {
+	var bytes, keys int64
+	for _, c := range changes {
+		if !c.deleted {
+			bytes += entrySize(c.entry)
+			keys++
+		}
+	}
+	if b.maxBytes > 0 && bytes > b.maxBytes || b.maxKeys > 0 && keys > b.maxKeys {
+		return ErrTooLarge
+	}
+	return nil
+}
+
+type evictionMetrics struct {
+	evictions    prometheus.Counter
+	evictedBytes prometheus.Counter
+	bytes        prometheus.GaugeFunc
+	keys         prometheus.GaugeFunc
+}
+
+func newEvictionMetrics(b *budget) *evictionMetrics {
+	return &evictionMetrics{
+		evictions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "mapkv_evictions_total",
+			Help: "Keys evicted to keep the store within its budget.",
+		}),
+		evictedBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "mapkv_evicted_bytes_total",
+			Help: "Estimated memory of the evicted keys.",
+		}),
+		bytes: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "mapkv_memory_bytes",
+			Help: "Estimated memory of the keys counted against the budget.",
+		}, func() float64 { return float64(b.bytes.Load()) }),
+		keys: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "mapkv_keys",
+			Help: "Keys counted against the budget, including expired keys not swept yet.",
+		}, func() float64 { return float64(b.keys.Load()) }),
+	}
+}
+
+func (m *evictionMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.evictions.Describe(ch)
+	m.evictedBytes.Describe(ch)
+	m.bytes.Describe(ch)
+	m.keys.Describe(ch)
+}
+
+func (m *evictionMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.evictions.Collect(ch)
+	m.evictedBytes.Collect(ch)
+	m.bytes.Collect(ch)
+	m.keys.Collect(ch)
+}
+
+// insert stores the entry and accounts for it. It must be called with
+// the shard locked.
+func (sh *shard) insert(e entry) {
+	old, replaced := sh.tree.ReplaceOrInsert(e)
+	if sh.budget == nil {
+		return
+	}
+
+	size := entrySize(e)
+	if replaced {
+		size -= entrySize(old)
+	} else {
+		sh.budget.keys.Add(1)
+	}
+	sh.budget.bytes.Add(size)
+
+	sh.evictMu.Lock()
+	defer sh.evictMu.Unlock()
+	if replaced {
+		sh.tracker.touch(e.key)
+	} else {
+		sh.tracker.add(e.key)
+	}
+}
+
+// remove deletes the key and returns its entry. It must be called with
+// the shard locked.
+func (sh *shard) remove(key string) (entry, bool) {
+	old, ok := sh.tree.Delete(entry{key: key})
+	if !ok || sh.budget == nil {
+		return old, ok
+	}
+
+	sh.budget.bytes.Add(-entrySize(old))
+	sh.budget.keys.Add(-1)
+
+	sh.evictMu.Lock()
+	defer sh.evictMu.Unlock()
+	sh.tracker.remove(key)
+	return old, true
+}
+
+// touch records a read of the key, the read lock of the shard is
+// enough.
+func (sh *shard) touch(key string) {
+	if sh.budget == nil {
+		return
+	}
+
+	sh.evictMu.Lock()
+	defer sh.evictMu.Unlock()
+	sh.tracker.touch(key)
+}
+
+// evictOne evicts the victim of the tracker and reports whether there
+// was one. It must be called with the shard locked.
+func (sh *shard) evictOne(protected func(string) bool) bool {
+	sh.evictMu.Lock()
+	key, ok := sh.tracker.victim(protected)
+	sh.evictMu.Unlock()
+	if !ok {
+		return false
+	}
+
+	if e, ok := sh.remove(key); ok {
+		sh.budget.metrics.evictions.Inc()
+		sh.budget.metrics.evictedBytes.Add(float64(entrySize(e)))
+	}
+	return true
+}
+
+// enforceBudget evicts keys until the store is back within its budget.
+// It is called by commit with the shards of the changes locked, the
+// keys of the changes are never evicted. One key is evicted per shard
+// in turn, so the policy is applied roughly across the whole store.
+// Other shards are evicted only if they can be locked without waiting,
+// waiting with shards held could deadlock, and the background evicts
+// what is left.
+func (s *Store) enforceBudget(changes []change) {
+	if s.budget == nil || !s.budget.exceeded() {
+		return
+	}
+
+	var (
+		written = make(map[string]struct{}, len(changes))
+		held    = make(map[*shard]bool)
+	)
+	for _, c := range changes {
+		written[c.entry.key] = struct{}{}
+		held[s.shardOf(c.entry.key)] = true
+	}
+	protected := func(key string) bool {
+		_, ok := written[key]
+		return ok
+	}
+
+	start := int(s.evictNext.Add(1))
+	for evicted := true; evicted; {
+		evicted = false
+		for i := range s.shards {
+			sh := s.shards[(start+i)%len(s.shards)]
+			if !held[sh] && !sh.mu.TryLock() {
+				continue
+			}
+			if sh.evictOne(protected) {
+				evicted = true
+			}
+			if !held[sh] {
+				sh.mu.Unlock()
+			}
+
+			if !s.budget.exceeded() {
+				return
+			}
+		}
+	}
+
+	select {
+	case s.evictC <- struct{}{}:
+	default:
+	}
+}
+
+// evictAll evicts keys of any shard until the store is within its
+// budget, locking one shard at a time.
+func (s *Store) evictAll() {
+	for evicted := true; evicted && s.budget.exceeded(); {
+		evicted = false
+		for _, sh := range s.shards {
+			sh.mu.Lock()
+			if sh.evictOne(nil) {
+				evicted = true
+			}
+			sh.mu.Unlock()
+
+			if !s.budget.exceeded() {
+				return
+			}
+		}
+	}
+}
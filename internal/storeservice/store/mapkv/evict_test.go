@@ -0,0 +1,280 @@
+package mapkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvtests"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newBounded(tb testing.TB, cfg Config, deps Dependencies) *Store {
+	tb.Helper()
+	if deps.Log == nil {
+		deps.Log = logrus.StandardLogger()
+	}
+	s, err := New(cfg, deps)
+	require.NoError(tb, err)
+	return s.(*Store)
+}
+
+// requireAccounted checks the usage of the budget against the trees.
+func requireAccounted(tb testing.TB, s *Store) {
+	tb.Helper()
+	s.lockAll()
+	defer unlockShards(s.shards)
+
+	var bytes, keys int64
+	for _, sh := range s.shards {
+		sh.tree.Ascend(func(e entry) bool {
+			bytes += entrySize(e)
+			keys++
+			return true
+		})
+	}
+	require.Equal(tb, bytes, s.budget.bytes.Load())
+	require.Equal(tb, keys, s.budget.keys.Load())
+}
+
+func TestMapKVBounded(t *testing.T) {
+	// A budget which is never reached changes nothing.
+	kvtests.RunTests(t, func(tb testing.TB) kv.Store {
+		s := newBounded(tb, Config{MaxBytes: 1 << 30, MaxKeys: 1 << 20}, Dependencies{})
+		tb.Cleanup(func() {
+			requireAccounted(tb, s)
+			require.Zero(tb, testutil.ToFloat64(s.budget.metrics.evictions))
+		})
+		return s
+	})
+}
+
+func TestMapKVEviction(t *testing.T) {
+	for _, policy := range []string{"lru", "lfu", "random"} {
+		t.Run(policy, func(t *testing.T) {
+			eviction, err := ParseEvictionPolicy(policy)
+			require.NoError(t, err)
+
+			kvtests.RunEvictionTests(t, func(tb testing.TB) kv.Store {
+				s := newBounded(tb, Config{MaxKeys: 200, MaxBytes: 200 * 160, Eviction: eviction}, Dependencies{})
+				tb.Cleanup(func() {
+					require.False(tb, s.budget.exceeded())
+					require.NotZero(tb, testutil.ToFloat64(s.budget.metrics.evictions))
+					requireAccounted(tb, s)
+				})
+				return s
+			})
+		})
+	}
+}
+
+func TestEvictionPolicy(t *testing.T) {
+	ctx := context.Background()
+	set := func(s kv.Store, keys ...string) {
+		for _, key := range keys {
+			require.NoError(t, s.Set(ctx, kv.Key(key), kv.Value("val")))
+		}
+	}
+	get := func(s kv.Store, keys ...string) {
+		for _, key := range keys {
+			_, err := s.Get(ctx, kv.Key(key))
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("lru", func(t *testing.T) {
+		s := newBounded(t, Config{Shards: 1, MaxKeys: 3, Eviction: EvictLRU}, Dependencies{})
+		defer s.Close()
+
+		set(s, "a", "b", "c")
+		get(s, "a")
+		set(s, "d")
+		require.Equal(t, []string{"a", "c", "d"}, scanKeys(t, s, kv.ScanOptions{}))
+		set(s, "c", "e")
+		require.Equal(t, []string{"c", "d", "e"}, scanKeys(t, s, kv.ScanOptions{}))
+	})
+
+	t.Run("lfu", func(t *testing.T) {
+		s := newBounded(t, Config{Shards: 1, MaxKeys: 3, Eviction: EvictLFU}, Dependencies{})
+		defer s.Close()
+
+		set(s, "a", "b", "c")
+		get(s, "a", "a", "c")
+		set(s, "d")
+		require.Equal(t, []string{"a", "c", "d"}, scanKeys(t, s, kv.ScanOptions{}))
+		// The least used key goes even if it is the newest.
+		set(s, "e")
+		require.Equal(t, []string{"a", "c", "e"}, scanKeys(t, s, kv.ScanOptions{}))
+	})
+
+	t.Run("random", func(t *testing.T) {
+		s := newBounded(t, Config{Shards: 1, MaxKeys: 3, Eviction: EvictRandom}, Dependencies{})
+		defer s.Close()
+
+		set(s, "a", "b", "c", "d", "e")
+		keys := scanKeys(t, s, kv.ScanOptions{})
+		require.Len(t, keys, 3)
+		require.Contains(t, keys, "e")
+	})
+
+	_, err := ParseEvictionPolicy("fifo")
+	require.Error(t, err)
+}
+
+func TestEvictionTooLarge(t *testing.T) {
+	ctx := context.Background()
+	s := newBounded(t, Config{MaxBytes: 1024, MaxKeys: 4}, Dependencies{})
+	defer s.Close()
+
+	err := s.Set(ctx, kv.Key("big"), make(kv.Value, 1024))
+	require.ErrorIs(t, err, ErrTooLarge)
+
+	var ops []kv.Op
+	for i := 0; i < 5; i++ {
+		ops = append(ops, kv.Op{Type: kv.OpSet, Key: kv.Key(fmt.Sprint(i)), Value: kv.Value("v")})
+	}
+	require.ErrorIs(t, s.Write(ctx, ops), ErrTooLarge)
+	require.NoError(t, s.Write(ctx, ops[:4]))
+	requireAccounted(t, s)
+}
+
+// TestEvictionConcurrent checks the budget under concurrent writers. A
+// writer evicts right after its write, so the usage exceeds the budget
+// by at most one write per writer.
+func TestEvictionConcurrent(t *testing.T) {
+	const (
+		writers  = 8
+		ops      = 3000
+		maxBytes = 64 << 10
+		valueLen = 100
+	)
+	for _, policy := range []string{"lru", "lfu", "random"} {
+		t.Run(policy, func(t *testing.T) {
+			eviction, err := ParseEvictionPolicy(policy)
+			require.NoError(t, err)
+
+			s := newBounded(t, Config{MaxBytes: maxBytes, Eviction: eviction}, Dependencies{})
+			defer s.Close()
+
+			var (
+				ctx       = context.Background()
+				wg        sync.WaitGroup
+				stop      = make(chan struct{})
+				sampled   = make(chan int64)
+				slack     = int64(writers * 2 * (entryOverhead + valueLen + 16))
+				value     = make(kv.Value, valueLen)
+				writeErrs = make(chan error, writers)
+			)
+			go func() {
+				var peak int64
+				for {
+					select {
+					case <-stop:
+						sampled <- peak
+						return
+					default:
+						peak = max(peak, s.budget.bytes.Load())
+					}
+				}
+			}()
+
+			for w := 0; w < writers; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					for i := 0; i < ops; i++ {
+						key := kv.Key(fmt.Sprintf("w%d/%05d", w, i%500))
+						var err error
+						switch i % 10 {
+						case 0:
+							err = s.Delete(ctx, key)
+						case 1:
+							err = s.Write(ctx, []kv.Op{
+								{Type: kv.OpSet, Key: key, Value: value},
+								{Type: kv.OpSet, Key: append(key, 'b'), Value: value},
+							})
+						case 2, 3:
+							if _, err = s.Get(ctx, key); errors.Is(err, kv.ErrNotFound) {
+								err = nil
+							}
+						default:
+							err = s.Set(ctx, key, value)
+						}
+						if err != nil {
+							writeErrs <- err
+							return
+						}
+					}
+				}(w)
+			}
+			wg.Wait()
+			close(stop)
+			peak := <-sampled
+			close(writeErrs)
+			for err := range writeErrs {
+				require.NoError(t, err)
+			}
+
+			require.LessOrEqual(t, peak, int64(maxBytes)+slack)
+			require.Eventually(t, func() bool { return !s.budget.exceeded() }, time.Second, 10*time.Millisecond)
+			requireAccounted(t, s)
+		})
+	}
+}
+
+func TestEvictionMetrics(t *testing.T) {
+	ctx := context.Background()
+	reg := prometheus.NewRegistry()
+	s := newBounded(t, Config{MaxKeys: 10}, Dependencies{Registry: reg})
+
+	for i := 0; i < 25; i++ {
+		require.NoError(t, s.Set(ctx, kv.Key(fmt.Sprintf("key-%02d", i)), kv.Value("value")))
+	}
+
+	require.Equal(t, float64(15), testutil.ToFloat64(s.budget.metrics.evictions))
+	require.Equal(t, float64(15*entrySize(entry{key: "key-00", value: kv.Value("value")})), testutil.ToFloat64(s.budget.metrics.evictedBytes))
+	require.Equal(t, float64(10), testutil.ToFloat64(s.budget.metrics.keys))
+
+	count, err := testutil.GatherAndCount(reg)
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+
+	// The metrics are unregistered on close, a new store registers them
+	// again.
+	require.NoError(t, s.Close())
+	s = newBounded(t, Config{MaxKeys: 10}, Dependencies{Registry: reg})
+	require.NoError(t, s.Close())
+}
+
+func TestEvictionRecover(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cfg = Config{Dir: t.TempDir(), MaxKeys: 50}
+	)
+
+	s := newBounded(t, Config{Dir: cfg.Dir}, Dependencies{})
+	for i := 0; i < 200; i++ {
+		require.NoError(t, s.Set(ctx, kv.Key(fmt.Sprintf("key-%03d", i)), kv.Value("value")))
+	}
+	require.NoError(t, s.Close())
+
+	// The log of an unbounded store is replayed within the budget.
+	s = newBounded(t, cfg, Dependencies{})
+	defer s.Close()
+	count, err := s.Count(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), count)
+	requireAccounted(t, s)
+}
+
+func TestNegativeBudget(t *testing.T) {
+	_, err := New(Config{MaxBytes: -1}, Dependencies{Log: logrus.StandardLogger()})
+	require.Error(t, err)
+}
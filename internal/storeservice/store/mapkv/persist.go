@@ -0,0 +1,274 @@
+package mapkv
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvdump"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// A snapshot file holds the version of the store followed by a kvdump of
+// the tree. snapshot-N holds every write logged before the segment N, so
+// recovery loads the last snapshot and replays the segments from N on.
+const (
+	snapshotPrefix = "snapshot-"
+	snapshotSuffix = ".dump"
+	tmpSuffix      = ".tmp"
+	dirMode        = 0o755
+)
+
+func snapshotPath(dir string, seq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%016d%s", snapshotPrefix, seq, snapshotSuffix))
+}
+
+// recover loads the data from cfg.Dir and opens the write-ahead log. It
+// must be called before the store is used.
+func (s *Store) recover() error {
+	if err := os.MkdirAll(s.cfg.Dir, dirMode); err != nil {
+		return err
+	}
+
+	files, err := os.ReadDir(s.cfg.Dir)
+	if err != nil {
+		return err
+	}
+
+	var (
+		snapshotSeq  uint64
+		haveSnapshot bool
+		segments     []uint64
+	)
+	for _, f := range files {
+		name := f.Name()
+		if seq, ok := parseSeq(name, snapshotPrefix, snapshotSuffix); ok {
+			if !haveSnapshot || seq > snapshotSeq {
+				snapshotSeq, haveSnapshot = seq, true
+			}
+		} else if seq, ok := parseSeq(name, walPrefix, walSuffix); ok {
+			segments = append(segments, seq)
+		} else if strings.HasSuffix(name, tmpSuffix) {
+			// A snapshot which was not finished.
+			os.Remove(filepath.Join(s.cfg.Dir, name))
+		}
+	}
+
+	if haveSnapshot {
+		if err := s.loadSnapshot(snapshotPath(s.cfg.Dir, snapshotSeq)); err != nil {
+			return err
+		}
+	}
+
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	seq := snapshotSeq
+	for i, segment := range segments {
+		if segment < snapshotSeq {
+			continue
+		}
+		if err := s.replaySegment(segment, i == len(segments)-1); err != nil {
+			return err
+		}
+		seq = segment
+	}
+
+	s.wal, err = openWAL(s.cfg.Dir, seq, s.cfg.Sync)
+	if err != nil {
+		return err
+	}
+
+	s.removeBefore(snapshotSeq)
+	return nil
+}
+
+func (s *Store) loadSnapshot(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var (
+		r       = bufio.NewReader(f)
+		version uint64
+	)
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return fmt.Errorf("read snapshot %s: %w", path, err)
+	}
+	s.version.Store(version)
+
+	dr, err := kvdump.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("read snapshot %s: %w", path, err)
+	}
+	for {
+		e, err := dr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read snapshot %s: %w", path, err)
+		}
+
+		s.shardOf(string(e.Key)).insert(entry{
+			key:       string(e.Key),
+			value:     e.Value,
+			version:   e.Version,
+			expiresAt: e.ExpiresAt,
+		})
+	}
+}
+
+// replaySegment applies the records of the segment. A torn record is
+// expected at the end of the last segment when the process crashed in
+// the middle of a write, it is cut off so new records follow the last
+// complete one.
+func (s *Store) replaySegment(seq uint64, last bool) error {
+	path := walPath(s.cfg.Dir, seq)
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var (
+		r      = bufio.NewReader(f)
+		offset int64
+	)
+	for {
+		version, changes, size, err := readRecord(r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(err, errTornRecord) && last {
+			s.log.WithField("segment", path).WithField("offset", offset).
+				Warn("dropping torn record at the end of the write-ahead log")
+			return os.Truncate(path, offset)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: segment %s at offset %d: %v", errCorruptWAL, path, offset, err)
+		}
+
+		s.applyChanges(version, changes)
+		s.version.Store(max(s.version.Load(), version))
+		offset += size
+	}
+}
+
+// persistSnapshot writes all shards to a new snapshot and removes the
+// snapshots and log segments it replaces.
+func (s *Store) persistSnapshot() error {
+	// The log is rotated with every shard locked, so the new segment
+	// starts exactly after the writes the clones hold.
+	s.lockAll()
+	trees, version := s.cloneLocked()
+	seq, err := s.wal.rotate()
+	unlockShards(s.shards)
+	if err != nil {
+		return err
+	}
+
+	if err := writeSnapshot(snapshotPath(s.cfg.Dir, seq), trees, version); err != nil {
+		return err
+	}
+
+	s.removeBefore(seq)
+	return nil
+}
+
+func writeSnapshot(path string, trees []*btree.BTreeG[entry], version uint64) error {
+	tmp := path + tmpSuffix
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	if err := dumpTrees(f, trees, version); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+func dumpTrees(w io.Writer, trees []*btree.BTreeG[entry], version uint64) error {
+	bw := bufio.NewWriter(w)
+	if err := binary.Write(bw, binary.LittleEndian, version); err != nil {
+		return err
+	}
+
+	dw, err := kvdump.NewWriter(bw)
+	if err != nil {
+		return err
+	}
+
+	err = mergeTrees(trees, kv.ScanOptions{}, time.Now(), func(e entry) error {
+		return dw.Write(kvdump.Entry{
+			Key:       []byte(e.key),
+			Value:     e.value,
+			Version:   e.version,
+			ExpiresAt: e.expiresAt,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := dw.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// removeBefore removes the snapshots and log segments older than seq.
+// Failures only leave garbage behind, so they are logged.
+func (s *Store) removeBefore(seq uint64) {
+	files, err := os.ReadDir(s.cfg.Dir)
+	if err != nil {
+		s.log.WithError(err).Warn("list data directory")
+		return
+	}
+
+	for _, f := range files {
+		name := f.Name()
+		fseq, ok := parseSeq(name, snapshotPrefix, snapshotSuffix)
+		if !ok {
+			fseq, ok = parseSeq(name, walPrefix, walSuffix)
+		}
+		if !ok || fseq >= seq {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(s.cfg.Dir, name)); err != nil {
+			s.log.WithError(err).Warn("remove obsolete file")
+		}
+	}
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,229 @@
+package mapkv
+
+import (
+	"container/heap"
+	"container/list"
+	"fmt"
+	"math/rand"
+)
+
+// EvictionPolicy chooses the keys evicted when the store exceeds its
+// budget.
+type EvictionPolicy int
+
+const (
+	// EvictLRU evicts the least recently read or written keys.
+	EvictLRU EvictionPolicy = iota
+	// EvictLFU evicts the least frequently read or written keys, the
+	// least recently used first among equally used ones.
+	EvictLFU
+	// EvictRandom evicts random keys, it keeps no order and costs the
+	// least.
+	EvictRandom
+)
+
+func ParseEvictionPolicy(s string) (EvictionPolicy, error) {
+	switch s {
+	case "lru":
+		return EvictLRU, nil
+	case "lfu":
+		return EvictLFU, nil
+	case "random":
+		return EvictRandom, nil
+	}
+	return 0, fmt.Errorf("unknown eviction policy %q", s)
+}
+
+// tracker orders the keys of a shard for eviction. It is locked by the
+// evictMu of its shard.
+type tracker interface {
+	// add records a new key, touch an access of a present key.
+	add(key string)
+	touch(key string)
+	remove(key string)
+	// victim returns the key to evict next. Keys for which protected
+	// returns true are skipped, nil protects none.
+	victim(protected func(string) bool) (string, bool)
+}
+
+func newTracker(policy EvictionPolicy) (tracker, error) {
+	switch policy {
+	case EvictLRU:
+		return &lruTracker{order: list.New(), elems: make(map[string]*list.Element)}, nil
+	case EvictLFU:
+		return &lfuTracker{items: make(map[string]*lfuItem)}, nil
+	case EvictRandom:
+		return &randomTracker{index: make(map[string]int)}, nil
+	}
+	return nil, fmt.Errorf("unknown eviction policy %d", policy)
+}
+
+type lruTracker struct {
+	// order has the most recently used key in front.
+	order *list.List
+	elems map[string]*list.Element
+}
+
+func (t *lruTracker) add(key string) {
+	t.elems[key] = t.order.PushFront(key)
+}
+
+func (t *lruTracker) touch(key string) {
+	if e, ok := t.elems[key]; ok {
+		t.order.MoveToFront(e)
+	}
+}
+
+func (t *lruTracker) remove(key string) {
+	if e, ok := t.elems[key]; ok {
+		t.order.Remove(e)
+		delete(t.elems, key)
+	}
+}
+
+func (t *lruTracker) victim(protected func(string) bool) (string, bool) {
+	for e := t.order.Back(); e != nil; e = e.Prev() {
+		key := e.Value.(string)
+		if protected == nil || !protected(key) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+type lfuItem struct {
+	key   string
+	count uint64
+	// tick is the time of the last use, it orders equally used keys.
+	tick  uint64
+	index int
+}
+
+// lfuTracker keeps the keys in a min-heap of use counts.
+type lfuTracker struct {
+	items map[string]*lfuItem
+	heap  lfuHeap
+	tick  uint64
+}
+
+func (t *lfuTracker) add(key string) {
+	t.tick++
+	item := &lfuItem{key: key, count: 1, tick: t.tick}
+	t.items[key] = item
+	heap.Push(&t.heap, item)
+}
+
+func (t *lfuTracker) touch(key string) {
+	if item, ok := t.items[key]; ok {
+		t.tick++
+		item.count++
+		item.tick = t.tick
+		heap.Fix(&t.heap, item.index)
+	}
+}
+
+func (t *lfuTracker) remove(key string) {
+	if item, ok := t.items[key]; ok {
+		heap.Remove(&t.heap, item.index)
+		delete(t.items, key)
+	}
+}
+
+func (t *lfuTracker) victim(protected func(string) bool) (string, bool) {
+	// Protected keys are popped until a victim is found and pushed back,
+	// there are only as many as a single write has.
+	var skipped []*lfuItem
+	defer func() {
+		for _, item := range skipped {
+			heap.Push(&t.heap, item)
+		}
+	}()
+
+	for t.heap.Len() > 0 {
+		item := t.heap[0]
+		if protected == nil || !protected(item.key) {
+			return item.key, true
+		}
+		skipped = append(skipped, heap.Pop(&t.heap).(*lfuItem))
+	}
+	return "", false
+}
+
+type lfuHeap []*lfuItem
+
+func (h lfuHeap) Len() int { return len(h) }
+
+func (h lfuHeap) Less(i, j int) bool {
+	if h[i].count != h[j].count {
+		return h[i].count < h[j].count
+	}
+	return h[i].tick < h[j].tick
+}
+
+func (h lfuHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *lfuHeap) Push(x any) {
+	item := x.(*lfuItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *lfuHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return item
+}
+
+// randomProbes is the number of random keys tried before the keys are
+// searched in order for one which is not protected.
+const randomProbes = 8
+
+type randomTracker struct {
+	keys  []string
+	index map[string]int
+}
+
+func (t *randomTracker) add(key string) {
+	t.index[key] = len(t.keys)
+	t.keys = append(t.keys, key)
+}
+
+func (t *randomTracker) touch(string) {}
+
+func (t *randomTracker) remove(key string) {
+	i, ok := t.index[key]
+	if !ok {
+		return
+	}
+
+	last := len(t.keys) - 1
+	t.keys[i] = t.keys[last]
+	t.index[t.keys[i]] = i
+	t.keys = t.keys[:last]
+	delete(t.index, key)
+}
+
+func (t *randomTracker) victim(protected func(string) bool) (string, bool) {
+	if len(t.keys) == 0 {
+		return "", false
+	}
+
+	for i := 0; i < randomProbes; i++ {
+		key := t.keys[rand.Intn(len(t.keys))]
+		if protected == nil || !protected(key) {
+			return key, true
+		}
+	}
+	for _, key := range t.keys {
+		if !protected(key) {
+			return key, true
+		}
+	}
+	return "", false
+}
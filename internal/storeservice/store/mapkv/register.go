@@ -0,0 +1,67 @@
+package mapkv
+
+import (
+	"kvstore/internal/storeservice/store"
+	"kvstore/internal/storeservice/store/kv"
+	"strconv"
+)
+
+func init() {
+	store.Register(store.Backend{
+		Name:  "map",
+		Usage: "in-memory B-tree storage, optionally persisted with a write-ahead log",
+		Options: []store.Option{
+			{Name: "dir", Usage: "directory of the write-ahead log and snapshots, empty keeps the data in memory only"},
+			{Name: "sync", Usage: "write-ahead log sync policy: always, interval or never", Default: "interval"},
+			{Name: "sync-interval", Usage: "how often the write-ahead log is synced with the interval policy", Default: defaultSyncInterval.String()},
+			{Name: "snapshot-interval", Usage: "how often the data is snapshotted", Default: defaultSnapshotInterval.String()},
+			{Name: "sweep-interval", Usage: "how often expired keys are removed", Default: defaultSweepInterval.String()},
+			{Name: "shards", Usage: "number of independently locked parts the keys are spread over", Default: strconv.Itoa(defaultShards)},
+			{Name: "max-bytes", Usage: "estimated memory the keys may take before others are evicted, 0 is unbounded", Default: "0"},
+			{Name: "max-keys", Usage: "number of keys kept before others are evicted, 0 is unbounded", Default: "0"},
+			{Name: "eviction", Usage: "eviction policy of a bounded store: lru, lfu or random", Default: "lru"},
+		},
+		New: newFromOptions,
+		TestOptions: func(dir string) store.Options {
+			return store.Options{"dir": dir}
+		},
+	})
+}
+
+func newFromOptions(opts store.Options, deps store.Dependencies) (kv.Store, error) {
+	var (
+		cfg = Config{Dir: opts.String("dir")}
+		err error
+	)
+	if cfg.Sync, err = ParseSyncPolicy(opts.String("sync")); err != nil {
+		return nil, err
+	}
+	if cfg.SyncInterval, err = opts.Duration("sync-interval"); err != nil {
+		return nil, err
+	}
+	if cfg.SnapshotInterval, err = opts.Duration("snapshot-interval"); err != nil {
+		return nil, err
+	}
+	if cfg.SweepInterval, err = opts.Duration("sweep-interval"); err != nil {
+		return nil, err
+	}
+	shards, err := opts.Int64("shards")
+	if err != nil {
+		return nil, err
+	}
+	cfg.Shards = int(shards)
+	if cfg.MaxBytes, err = opts.Int64("max-bytes"); err != nil {
+		return nil, err
+	}
+	if cfg.MaxKeys, err = opts.Int64("max-keys"); err != nil {
+		return nil, err
+	}
+	if cfg.Eviction, err = ParseEvictionPolicy(opts.String("eviction")); err != nil {
+		return nil, err
+	}
+
+	return New(cfg, Dependencies{
+		Log:      deps.Log,
+		Registry: deps.Registry,
+	})
+}
@@ -0,0 +1,259 @@
+package mapkv
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"hash/maphash"
+	"kvstore/internal/storeservice/store/kv"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// shard owns the keys hashing to it. Writes lock only the shards of the
+// keys they touch, so writers of different shards do not wait for each
+// other.
+type shard struct {
+	mu   sync.RWMutex
+	tree *btree.BTreeG[entry]
+
+	// budget is shared by the shards of a bounded store and nil
+	// otherwise. The tracker is locked by evictMu rather than mu, so
+	// reads holding the read lock record their accesses.
+	budget  *budget
+	evictMu sync.Mutex
+	tracker tracker
+}
+
+func (s *Store) shardIndex(key string) int {
+	return int(maphash.String(s.seed, key) % uint64(len(s.shards)))
+}
+
+func (s *Store) shardOf(key string) *shard {
+	return s.shards[s.shardIndex(key)]
+}
+
+// lockKeys locks the shards of the keys in index order, so writers
+// locking several shards do not deadlock. It returns the locked shards.
+func (s *Store) lockKeys(keys []string) []*shard {
+	indexes := make([]int, 0, len(keys))
+	seen := make(map[int]bool, len(keys))
+	for _, key := range keys {
+		i := s.shardIndex(key)
+		if !seen[i] {
+			seen[i] = true
+			indexes = append(indexes, i)
+		}
+	}
+	sort.Ints(indexes)
+
+	locked := make([]*shard, len(indexes))
+	for n, i := range indexes {
+		locked[n] = s.shards[i]
+		locked[n].mu.Lock()
+	}
+	return locked
+}
+
+// lockAll locks every shard, which stops all writers.
+func (s *Store) lockAll() {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+	}
+}
+
+func unlockShards(shards []*shard) {
+	for _, sh := range shards {
+		sh.mu.Unlock()
+	}
+}
+
+// clone returns copy-on-write clones of all shards and the version they
+// are at. Every shard is locked at once, so the clones are consistent
+// with each other.
+func (s *Store) clone() ([]*btree.BTreeG[entry], uint64) {
+	// Clone modifies the original tree, so it needs the exclusive lock.
+	s.lockAll()
+	defer unlockShards(s.shards)
+	return s.cloneLocked()
+}
+
+// cloneLocked is clone for callers which hold every shard locked.
+func (s *Store) cloneLocked() ([]*btree.BTreeG[entry], uint64) {
+	trees := make([]*btree.BTreeG[entry], len(s.shards))
+	for i, sh := range s.shards {
+		trees[i] = sh.tree.Clone()
+	}
+	return trees, s.version.Load()
+}
+
+// scanTrees iterates live entries of the trees in the range of the scan
+// in key order, entries expired at now are skipped.
+func scanTrees(ctx context.Context, trees []*btree.BTreeG[entry], opts kv.ScanOptions, now time.Time, f kv.ScanHandler) error {
+	limit := -1
+	if opts.Limit != 0 {
+		limit = opts.Limit
+	}
+
+	err := mergeTrees(trees, opts, now, func(e entry) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		limit--
+		if err := f(kv.Key(e.key), e.value); err != nil {
+			return err
+		}
+		if limit == 0 {
+			return kv.ErrStopScan
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, kv.ErrStopScan) {
+		return err
+	}
+	return nil
+}
+
+// A cursor reads minChunk entries first and doubles the chunk on every
+// read up to maxChunk, so short scans over many shards read few entries
+// which are not returned.
+const (
+	minChunk = 4
+	maxChunk = 256
+)
+
+// mergeTrees calls f with the live entries of all trees in the range of
+// the scan, merged in key order. Keys are partitioned between the trees,
+// so no key is seen twice. Iteration stops at the first error of f.
+func mergeTrees(trees []*btree.BTreeG[entry], opts kv.ScanOptions, now time.Time, f func(entry) error) error {
+	lower, upper := opts.Bounds()
+	h := &cursorHeap{reverse: opts.Reverse}
+	for _, tree := range trees {
+		c := &cursor{
+			tree:    tree,
+			lower:   string(lower),
+			upper:   string(upper),
+			bounded: upper != nil,
+			reverse: opts.Reverse,
+			now:     now,
+		}
+		if c.fill() {
+			h.cursors = append(h.cursors, c)
+		}
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		c := h.cursors[0]
+		if err := f(c.buf[c.pos]); err != nil {
+			return err
+		}
+
+		c.pos++
+		if c.pos < len(c.buf) || c.fill() {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return nil
+}
+
+// cursor reads a tree in chunks, so merging does not hold every entry
+// of the range in memory.
+type cursor struct {
+	tree         *btree.BTreeG[entry]
+	lower, upper string
+	bounded      bool
+	reverse      bool
+	now          time.Time
+
+	buf     []entry
+	pos     int
+	chunk   int
+	last    string
+	started bool
+	done    bool
+}
+
+// fill reads the next chunk and reports whether it has any entries.
+func (c *cursor) fill() bool {
+	if c.done {
+		return false
+	}
+	c.buf, c.pos = c.buf[:0], 0
+	c.chunk = min(max(2*c.chunk, minChunk), maxChunk)
+
+	iter := func(e entry) bool {
+		if c.started && e.key == c.last {
+			return true
+		}
+		if c.reverse {
+			if e.key < c.lower {
+				c.done = true
+				return false
+			}
+			if c.bounded && e.key >= c.upper {
+				return true
+			}
+		} else if c.bounded && e.key >= c.upper {
+			c.done = true
+			return false
+		}
+
+		if !e.expired(c.now) {
+			c.buf = append(c.buf, e)
+		}
+		c.last = e.key
+		return len(c.buf) < c.chunk
+	}
+
+	switch {
+	case c.started && c.reverse:
+		c.tree.DescendLessOrEqual(entry{key: c.last}, iter)
+	case c.started:
+		c.tree.AscendGreaterOrEqual(entry{key: c.last}, iter)
+	case c.reverse && c.bounded:
+		c.tree.DescendLessOrEqual(entry{key: c.upper}, iter)
+	case c.reverse:
+		c.tree.Descend(iter)
+	default:
+		c.tree.AscendGreaterOrEqual(entry{key: c.lower}, iter)
+	}
+	c.started = true
+
+	// The tree ended before the chunk was full.
+	if len(c.buf) < c.chunk {
+		c.done = true
+	}
+	return len(c.buf) > 0
+}
+
+type cursorHeap struct {
+	cursors []*cursor
+	reverse bool
+}
+
+func (h *cursorHeap) Len() int { return len(h.cursors) }
+
+func (h *cursorHeap) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	if h.reverse {
+		return a.buf[a.pos].key > b.buf[b.pos].key
+	}
+	return a.buf[a.pos].key < b.buf[b.pos].key
+}
+
+func (h *cursorHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *cursorHeap) Push(x any) { h.cursors = append(h.cursors, x.(*cursor)) }
+
+func (h *cursorHeap) Pop() any {
+	c := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return c
+}
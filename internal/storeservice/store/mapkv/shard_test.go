@@ -0,0 +1,178 @@
+package mapkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newSharded(tb testing.TB, shards int) *Store {
+	tb.Helper()
+	log := logrus.New()
+	log.SetLevel(logrus.WarnLevel)
+	s, err := New(Config{Shards: shards}, Dependencies{Log: log})
+	require.NoError(tb, err)
+	return s.(*Store)
+}
+
+func scanKeys(t *testing.T, s kv.Store, opts kv.ScanOptions) []string {
+	t.Helper()
+	var keys []string
+	err := s.Scan(context.Background(), opts, func(k kv.Key, _ kv.Value) error {
+		keys = append(keys, string(k))
+		return nil
+	})
+	require.NoError(t, err)
+	return keys
+}
+
+// TestShardedScan checks that scans merged across shards return the
+// same keys in the same order as a single shard.
+func TestShardedScan(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		single  = newSharded(t, 1)
+		sharded = newSharded(t, 7)
+		rnd     = rand.New(rand.NewSource(1))
+	)
+	defer single.Close()
+	defer sharded.Close()
+
+	for i := 0; i < 1000; i++ {
+		key := kv.Key(fmt.Sprintf("%c/%04d", 'a'+rnd.Intn(4), rnd.Intn(10000)))
+		require.NoError(t, single.Set(ctx, key, kv.Value("v")))
+		require.NoError(t, sharded.Set(ctx, key, kv.Value("v")))
+	}
+
+	for _, opts := range []kv.ScanOptions{
+		{},
+		{Reverse: true},
+		{Prefix: kv.Key("b/")},
+		{Prefix: kv.Key("b/"), Reverse: true},
+		{Prefix: kv.Key("c/"), Limit: 100},
+		{Prefix: kv.Key("c/"), Limit: 100, Reverse: true},
+		{Start: kv.Key("a/5"), End: kv.Key("c/2")},
+		{Start: kv.Key("a/5"), End: kv.Key("c/2"), Reverse: true},
+		{Prefix: kv.Key("z/")},
+	} {
+		want := scanKeys(t, single, opts)
+		got := scanKeys(t, sharded, opts)
+		require.Equal(t, want, got, "%+v", opts)
+		require.True(t, opts.Reverse || sort.StringsAreSorted(got), "%+v", opts)
+	}
+}
+
+func TestShardedWriteAtomic(t *testing.T) {
+	var (
+		ctx = context.Background()
+		s   = newSharded(t, 8)
+		wg  sync.WaitGroup
+	)
+	defer s.Close()
+
+	// Writers set every key of the batch to the same value, a snapshot
+	// must never see a batch applied to only some of the shards.
+	keys := make([]kv.Key, 32)
+	for i := range keys {
+		keys[i] = kv.Key(fmt.Sprintf("atomic/%02d", i))
+	}
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				ops := make([]kv.Op, len(keys))
+				for j, key := range keys {
+					ops[j] = kv.Op{Type: kv.OpSet, Key: key, Value: kv.Value(fmt.Sprintf("%d-%d", w, i))}
+				}
+				if err := s.Write(ctx, ops); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+
+	for i := 0; i < 100; i++ {
+		values := make(map[string]bool)
+		err := s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key("atomic/")}, func(_ kv.Key, v kv.Value) error {
+			values[string(v)] = true
+			return nil
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(values), 1)
+	}
+	wg.Wait()
+}
+
+// TestShardedWatchOrder checks that writes to different shards reach a
+// watcher in version order, which resuming from a version relies on.
+func TestShardedWatchOrder(t *testing.T) {
+	const writers, writes = 4, 250
+
+	var (
+		ctx      = context.Background()
+		s        = newSharded(t, 8)
+		errDone  = errors.New("done")
+		ready    = make(chan struct{})
+		once     sync.Once
+		versions []uint64
+		wg       sync.WaitGroup
+	)
+	defer s.Close()
+
+	watched := make(chan error, 1)
+	go func() {
+		watched <- s.Watch(ctx, kv.Key("order/"), 0, func(e kv.Event) error {
+			if string(e.Key) == "order/ready" {
+				once.Do(func() { close(ready) })
+				return nil
+			}
+			versions = append(versions, e.Version)
+			if len(versions) == writers*writes {
+				return errDone
+			}
+			return nil
+		})
+	}()
+
+	// Writes made once the watcher got the marker are all delivered
+	// live rather than by the catch up.
+	for waiting := true; waiting; {
+		require.NoError(t, s.Set(ctx, kv.Key("order/ready"), kv.Value("1")))
+		select {
+		case <-ready:
+			waiting = false
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < writes; i++ {
+				key := kv.Key(fmt.Sprintf("order/%d/%03d", w, i))
+				if err := s.Set(ctx, key, kv.Value("v")); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	require.ErrorIs(t, <-watched, errDone)
+	for i := 1; i < len(versions); i++ {
+		require.Greater(t, versions[i], versions[i-1])
+	}
+}
@@ -0,0 +1,52 @@
+package mapkv
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// snapshot holds copy-on-write clones of the shards, so taking it is cheap
+// and writers only copy the nodes they modify. Expiration is evaluated
+// at the moment the snapshot was taken.
+type snapshot struct {
+	s     *Store
+	trees []*btree.BTreeG[entry]
+	at    time.Time
+}
+
+func (s *Store) Snapshot(ctx context.Context) (kv.Snapshot, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	trees, _ := s.clone()
+	return &snapshot{
+		s:     s,
+		trees: trees,
+		at:    time.Now(),
+	}, nil
+}
+
+func (s *snapshot) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	key := string(k)
+	e, ok := s.trees[s.s.shardIndex(key)].Get(entry{key: key})
+	if !ok || e.expired(s.at) {
+		return nil, kv.ErrNotFound
+	}
+	return e.value, nil
+}
+
+func (s *snapshot) Scan(ctx context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
+	return scanTrees(ctx, s.trees, opts, s.at, f)
+}
+
+func (s *snapshot) Close() error {
+	return nil
+}
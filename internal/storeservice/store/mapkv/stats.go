@@ -0,0 +1,79 @@
+package mapkv
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// Count measures clones of the shards, so writers are not blocked while
+// the keys are counted.
+func (s *Store) Count(ctx context.Context, prefix kv.Key) (int64, error) {
+	return s.count(ctx, kv.ScanOptions{Prefix: prefix})
+}
+
+func (s *Store) CountRange(ctx context.Context, start, end kv.Key) (int64, error) {
+	return s.count(ctx, kv.ScanOptions{Start: start, End: end})
+}
+
+func (s *Store) count(ctx context.Context, opts kv.ScanOptions) (int64, error) {
+	var (
+		trees, _ = s.clone()
+		now      = time.Now()
+		count    int64
+	)
+	for _, tree := range trees {
+		keys, _, err := measureTree(ctx, tree, opts, now)
+		if err != nil {
+			return 0, err
+		}
+		count += keys
+	}
+	return count, nil
+}
+
+func (s *Store) Stats(ctx context.Context) (kv.Stats, error) {
+	var (
+		trees, _ = s.clone()
+		now      = time.Now()
+		stats    kv.Stats
+	)
+	for _, tree := range trees {
+		keys, size, err := measureTree(ctx, tree, kv.ScanOptions{}, now)
+		if err != nil {
+			return kv.Stats{}, err
+		}
+		stats.Keys += keys
+		stats.LogicalBytes += size
+	}
+
+	if s.cfg.Dir != "" {
+		var err error
+		if stats.DiskBytes, err = kv.DirSize(s.cfg.Dir); err != nil {
+			return kv.Stats{}, err
+		}
+	}
+	return stats, nil
+}
+
+// measureTree returns the number and the total size of the live entries
+// of the tree within the bounds of opts.
+func measureTree(ctx context.Context, tree *btree.BTreeG[entry], opts kv.ScanOptions, now time.Time) (keys, size int64, err error) {
+	lower, upper := opts.Bounds()
+	tree.AscendGreaterOrEqual(entry{key: string(lower)}, func(e entry) bool {
+		if upper != nil && e.key >= string(upper) {
+			return false
+		}
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+		if !e.expired(now) {
+			keys++
+			size += int64(len(e.key) + len(e.value))
+		}
+		return true
+	})
+	return keys, size, err
+}
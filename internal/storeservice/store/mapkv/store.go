@@ -2,69 +2,475 @@ package mapkv
 
 import (
 	"context"
+	"fmt"
+	"hash/maphash"
 	"kvstore/internal/storeservice/store/kv"
-	"strings"
+	"kvstore/internal/storeservice/store/watch"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/btree"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultSweepInterval    = time.Second
+	defaultSyncInterval     = time.Second
+	defaultSnapshotInterval = 10 * time.Minute
+	defaultShards           = 16
+	btreeDegree             = 32
 )
 
+type Config struct {
+	// Shards is the number of independently locked parts the keys are
+	// spread over by their hash.
+	Shards int
+	// SweepInterval is how often expired keys are removed in background.
+	SweepInterval time.Duration
+
+	// Dir enables persistence: writes are appended to a write-ahead log
+	// in the directory and the tree is snapshotted there periodically.
+	// Empty Dir keeps the data in memory only.
+	Dir string
+	// Sync is the policy of syncing the write-ahead log to disk.
+	Sync SyncPolicy
+	// SyncInterval is how often the log is synced with SyncOnInterval.
+	SyncInterval time.Duration
+	// SnapshotInterval is how often the tree is snapshotted, the log
+	// written before a snapshot is removed.
+	SnapshotInterval time.Duration
+
+	// MaxBytes and MaxKeys bound the store, zero leaves it unbounded.
+	// The memory of a key is estimated from the lengths of the key and
+	// the value. A write exceeding a bound evicts other keys, like
+	// expired keys they are neither logged nor watched, so replaying the
+	// log restores them and they are evicted again.
+	MaxBytes int64
+	MaxKeys  int64
+	// Eviction chooses the keys evicted to stay within the bounds.
+	Eviction EvictionPolicy
+}
+
+type Dependencies struct {
+	Log *logrus.Logger
+	// Registry gets the eviction metrics of a bounded store, nil leaves
+	// them out.
+	Registry *prometheus.Registry
+}
+
+type entry struct {
+	key       string
+	value     kv.Value
+	version   uint64
+	expiresAt time.Time
+}
+
+func lessEntry(a, b entry) bool {
+	return a.key < b.key
+}
+
+func (e entry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
 type Store struct {
-	m  map[string]kv.Value
-	mu sync.RWMutex
+	cfg  Config
+	deps Dependencies
+	log  *logrus.Entry
+
+	shards []*shard
+	seed   maphash.Seed
+	// version is the last version assigned to a write. commitMu keeps
+	// the writes to different shards in version order in the log and
+	// in the events published to the watchers.
+	version  atomic.Uint64
+	commitMu sync.Mutex
+	// wal is nil when the store is not persistent. Writes of all shards
+	// share it, it is locked on its own.
+	wal *wal
+	hub *watch.Hub
+	// budget is nil when the store is unbounded. evictNext spreads the
+	// evictions over the shards, evictC wakes the background up to
+	// evict what writers could not.
+	budget    *budget
+	evictNext atomic.Uint64
+	evictC    chan struct{}
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
+// NewStore returns a store which keeps the data in memory only.
 func NewStore() kv.Store {
-	return &Store{
-		m: make(map[string]kv.Value),
+	s, _ := New(Config{}, Dependencies{Log: logrus.StandardLogger()})
+	return s
+}
+
+func New(cfg Config, deps Dependencies) (kv.Store, error) {
+	if cfg.SweepInterval <= 0 {
+		cfg.SweepInterval = defaultSweepInterval
+	}
+	if cfg.SyncInterval <= 0 {
+		cfg.SyncInterval = defaultSyncInterval
 	}
+	if cfg.SnapshotInterval <= 0 {
+		cfg.SnapshotInterval = defaultSnapshotInterval
+	}
+	if cfg.Shards <= 0 {
+		cfg.Shards = defaultShards
+	}
+	if cfg.MaxBytes < 0 || cfg.MaxKeys < 0 {
+		return nil, fmt.Errorf("negative budget: %d bytes, %d keys", cfg.MaxBytes, cfg.MaxKeys)
+	}
+
+	s := &Store{
+		cfg:    cfg,
+		deps:   deps,
+		log:    deps.Log.WithField("component", "mapkv"),
+		seed:   maphash.MakeSeed(),
+		hub:    watch.NewHub(),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+		evictC: make(chan struct{}, 1),
+	}
+	if cfg.MaxBytes > 0 || cfg.MaxKeys > 0 {
+		s.budget = newBudget(cfg.MaxBytes, cfg.MaxKeys)
+	}
+	s.shards = make([]*shard, cfg.Shards)
+	for i := range s.shards {
+		s.shards[i] = &shard{
+			tree:   btree.NewG(btreeDegree, lessEntry),
+			budget: s.budget,
+		}
+		if s.budget != nil {
+			var err error
+			if s.shards[i].tracker, err = newTracker(cfg.Eviction); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if cfg.Dir != "" {
+		if err := s.recover(); err != nil {
+			return nil, fmt.Errorf("recover mapkv at %s: %w", cfg.Dir, err)
+		}
+	}
+	if s.budget != nil {
+		// The log was replayed without evictions.
+		s.evictAll()
+
+		if deps.Registry != nil {
+			if err := deps.Registry.Register(s.budget.metrics); err != nil {
+				if s.wal != nil {
+					s.wal.close()
+				}
+				return nil, fmt.Errorf("register mapkv metrics: %w", err)
+			}
+		}
+	}
+
+	go s.background()
+	return s, nil
 }
 
-func (s *Store) Set(_ context.Context, k kv.Key, v kv.Value) error {
-	st := string(k)
+func (s *Store) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	return s.SetWithTTL(ctx, k, v, 0)
+}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.m[st] = v
-	return nil
+func (s *Store) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e := newEntry(k, v, ttl, time.Now())
+
+	sh := s.shardOf(e.key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return s.put(e)
+}
+
+func (s *Store) SetIfVersion(ctx context.Context, k kv.Key, v kv.Value, version uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	e := newEntry(k, v, 0, now)
+
+	sh := s.shardOf(e.key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if cur, ok := s.lookup(e.key, now); !ok || cur.version != version {
+		return kv.ErrConflict
+	}
+	return s.put(e)
+}
+
+func (s *Store) SetIfAbsent(ctx context.Context, k kv.Key, v kv.Value) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	e := newEntry(k, v, 0, now)
+
+	sh := s.shardOf(e.key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if _, ok := s.lookup(e.key, now); ok {
+		return kv.ErrConflict
+	}
+	return s.put(e)
 }
 
-func (s *Store) Get(_ context.Context, k kv.Key) (kv.Value, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	if v, ok := s.m[string(k)]; !ok {
-		return nil, kv.ErrNotFound
+func (s *Store) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	v, _, err := s.GetWithVersion(ctx, k)
+	return v, err
+}
+
+func (s *Store) GetWithVersion(ctx context.Context, k kv.Key) (kv.Value, uint64, error) {
+	v, version, _, err := s.GetWithExpiry(ctx, k)
+	return v, version, err
+}
+
+func (s *Store) GetWithExpiry(ctx context.Context, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	sh := s.shardOf(string(k))
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	if e, ok := s.lookup(string(k), time.Now()); !ok {
+		return nil, 0, time.Time{}, kv.ErrNotFound
 	} else {
-		return v, nil
+		sh.touch(e.key)
+		return e.value, e.version, e.expiresAt, nil
+	}
+}
+
+func (s *Store) Delete(ctx context.Context, k kv.Key) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	sh := s.shardOf(string(k))
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return s.delete(string(k))
+}
+
+func (s *Store) DeleteIfVersion(ctx context.Context, k kv.Key, version uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	sh := s.shardOf(string(k))
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if cur, ok := s.lookup(string(k), time.Now()); !ok || cur.version != version {
+		return kv.ErrConflict
 	}
+	return s.delete(string(k))
 }
 
-func (s *Store) Delete(_ context.Context, k kv.Key) error {
-	st := string(k)
+func (s *Store) Write(ctx context.Context, ops []kv.Op) error {
+	if err := kv.ValidateOps(ops); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	keys := make([]string, len(ops))
+	for i, op := range ops {
+		keys[i] = string(op.Key)
+	}
+
+	defer unlockShards(s.lockKeys(keys))
+	return s.apply(ops, time.Now())
+}
+
+// Scan iterates clones of the shards, so a long scan does not block
+// writers and still sees a consistent state. No lock is held while f
+// runs, so it may write to the store.
+func (s *Store) Scan(ctx context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	trees, _ := s.clone()
+	return scanTrees(ctx, trees, opts, time.Now(), f)
+}
+
+func (s *Store) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+		s.hub.Close()
+		if s.budget != nil && s.deps.Registry != nil {
+			s.deps.Registry.Unregister(s.budget.metrics)
+		}
+
+		if s.wal != nil {
+			err = s.wal.close()
+		}
+	})
+	return err
+}
+
+func newEntry(k kv.Key, v kv.Value, ttl time.Duration, now time.Time) entry {
+	e := entry{key: string(k), value: v}
+	if ttl > 0 {
+		e.expiresAt = now.Add(ttl)
+	}
+	return e
+}
+
+// lookup returns a live entry for the key. It must be called with the
+// shard of the key locked.
+func (s *Store) lookup(key string, now time.Time) (entry, bool) {
+	e, ok := s.shardOf(key).tree.Get(entry{key: key})
+	if !ok || e.expired(now) {
+		return entry{}, false
+	}
+	return e, true
+}
+
+// put stores the entry with the next version. It must be called with
+// the shard of the key locked.
+func (s *Store) put(e entry) error {
+	return s.commit([]change{{entry: e}})
+}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	delete(s.m, st)
+// delete removes the key. It must be called with the shard of the key
+// locked.
+func (s *Store) delete(key string) error {
+	return s.commit([]change{{deleted: true, entry: entry{key: key}}})
+}
+
+// apply performs the operations as a single write. It must be called
+// with the shards of all keys locked.
+func (s *Store) apply(ops []kv.Op, now time.Time) error {
+	changes := make([]change, 0, len(ops))
+	for _, op := range ops {
+		switch op.Type {
+		case kv.OpSet:
+			changes = append(changes, change{entry: newEntry(op.Key, op.Value, op.TTL, now)})
+		case kv.OpDelete:
+			changes = append(changes, change{deleted: true, entry: entry{key: string(op.Key)}})
+		}
+	}
+	return s.commit(changes)
+}
+
+// commit logs the changes, applies them to the shards and publishes
+// them to the watchers. All changes share a single version, the same
+// way a badger transaction shares its commit timestamp. It must be
+// called with the shards of all keys locked.
+func (s *Store) commit(changes []change) error {
+	if s.budget != nil {
+		if err := s.budget.check(changes); err != nil {
+			return err
+		}
+	}
+
+	s.commitMu.Lock()
+	version := s.version.Add(1)
+	if s.wal != nil {
+		if err := s.wal.append(version, changes); err != nil {
+			s.commitMu.Unlock()
+			return err
+		}
+	}
+
+	s.applyChanges(version, changes)
+	if s.hub.Watching() {
+		s.hub.Publish(changeEvents(version, changes)...)
+	}
+	s.commitMu.Unlock()
+
+	s.enforceBudget(changes)
 	return nil
 }
 
-func (s *Store) Scan(_ context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *Store) applyChanges(version uint64, changes []change) {
+	for _, c := range changes {
+		sh := s.shardOf(c.entry.key)
+		if c.deleted {
+			sh.remove(c.entry.key)
+			continue
+		}
+		c.entry.version = version
+		sh.insert(c.entry)
+	}
+}
+
+func (s *Store) background() {
+	defer close(s.done)
+
+	sweepTicker := time.NewTicker(s.cfg.SweepInterval)
+	defer sweepTicker.Stop()
 
-	limit := -1
-	if opts.Limit != 0 {
-		limit = opts.Limit
+	var syncC, snapshotC <-chan time.Time
+	if s.wal != nil {
+		if s.cfg.Sync == SyncOnInterval {
+			syncTicker := time.NewTicker(s.cfg.SyncInterval)
+			defer syncTicker.Stop()
+			syncC = syncTicker.C
+		}
+
+		snapshotTicker := time.NewTicker(s.cfg.SnapshotInterval)
+		defer snapshotTicker.Stop()
+		snapshotC = snapshotTicker.C
 	}
 
-	for k, v := range s.m {
-		if strings.HasPrefix(k, string(opts.Prefix)) {
-			limit--
-			if err := f(kv.Key(k), v); err == kv.ErrStopScan {
-				break
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-sweepTicker.C:
+			s.sweep()
+		case <-s.evictC:
+			s.evictAll()
+		case <-syncC:
+			if err := s.wal.sync(); err != nil {
+				s.log.WithError(err).Error("sync write-ahead log")
 			}
-			if limit == 0 {
-				break
+		case <-snapshotC:
+			if err := s.persistSnapshot(); err != nil {
+				s.log.WithError(err).Error("snapshot")
 			}
 		}
 	}
-	return nil
+}
+
+// sweep is not logged, replaying the log restores entries which expired
+// and they are swept again. Shards are swept one at a time, so writers
+// of the other shards are not blocked.
+func (s *Store) sweep() {
+	now := time.Now()
+	for _, sh := range s.shards {
+		sh.sweep(now)
+	}
+}
+
+func (sh *shard) sweep(now time.Time) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	var expired []entry
+	sh.tree.Ascend(func(e entry) bool {
+		if e.expired(now) {
+			expired = append(expired, e)
+		}
+		return true
+	})
+	for _, e := range expired {
+		sh.remove(e.key)
+	}
 }
@@ -1,10 +1,45 @@
 package mapkv
 
 import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
 	"kvstore/internal/storeservice/store/kvtests"
 	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
 )
 
+func newTestStore(testing.TB) kv.Store {
+	return NewStore()
+}
+
 func TestMapKV(t *testing.T) {
-	kvtests.RunTests(t, NewStore())
+	kvtests.RunTests(t, newTestStore)
+}
+
+func FuzzMapKV(f *testing.F) {
+	kvtests.Fuzz(f, newTestStore)
+}
+
+func TestSweeper(t *testing.T) {
+	store, err := New(Config{SweepInterval: 10 * time.Millisecond}, Dependencies{Log: logrus.StandardLogger()})
+	require.NoError(t, err)
+	s := store.(*Store)
+	defer s.Close()
+
+	ctx := context.Background()
+	err = s.SetWithTTL(ctx, kv.Key("key"), kv.Value("val"), 50*time.Millisecond)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		trees, _ := s.clone()
+		for _, tree := range trees {
+			if tree.Len() != 0 {
+				return false
+			}
+		}
+		return true
+	}, time.Second, 10*time.Millisecond)
 }
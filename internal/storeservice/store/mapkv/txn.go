@@ -0,0 +1,140 @@
+package mapkv
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+
+	"github.com/google/btree"
+)
+
+// txn reads from copy-on-write clones of the shards taken at Begin and
+// validates at Commit that every key it has read still has the version
+// observed, which gives the same guarantees as badger transactions.
+type txn struct {
+	s        *Store
+	snapshot []*btree.BTreeG[entry]
+
+	// reads holds versions of the keys read from the snapshot, zero
+	// means the key did not exist.
+	reads  map[string]uint64
+	writes map[string]kv.Op
+	closed bool
+}
+
+func (s *Store) Begin(ctx context.Context) (kv.Txn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	snapshot, _ := s.clone()
+
+	return &txn{
+		s:        s,
+		snapshot: snapshot,
+		reads:    make(map[string]uint64),
+		writes:   make(map[string]kv.Op),
+	}, nil
+}
+
+func (t *txn) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	if t.closed {
+		return nil, kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	key := string(k)
+	if op, ok := t.writes[key]; ok {
+		if op.Type == kv.OpDelete {
+			return nil, kv.ErrNotFound
+		}
+		return op.Value, nil
+	}
+
+	e, ok := t.snapshot[t.s.shardIndex(key)].Get(entry{key: key})
+	if !ok || e.expired(time.Now()) {
+		if _, seen := t.reads[key]; !seen {
+			t.reads[key] = 0
+		}
+		return nil, kv.ErrNotFound
+	}
+
+	if _, seen := t.reads[key]; !seen {
+		t.reads[key] = e.version
+	}
+	return e.value, nil
+}
+
+func (t *txn) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	return t.SetWithTTL(ctx, k, v, 0)
+}
+
+func (t *txn) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	t.writes[string(k)] = kv.Op{Type: kv.OpSet, Key: k, Value: v, TTL: ttl}
+	return nil
+}
+
+func (t *txn) Delete(ctx context.Context, k kv.Key) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	t.writes[string(k)] = kv.Op{Type: kv.OpDelete, Key: k}
+	return nil
+}
+
+func (t *txn) Commit(ctx context.Context) error {
+	if t.closed {
+		return kv.ErrTxnClosed
+	}
+	t.closed = true
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if len(t.writes) == 0 {
+		return nil
+	}
+
+	ops := make([]kv.Op, 0, len(t.writes))
+	keys := make([]string, 0, len(t.writes)+len(t.reads))
+	for key, op := range t.writes {
+		ops = append(ops, op)
+		keys = append(keys, key)
+	}
+	for key := range t.reads {
+		keys = append(keys, key)
+	}
+
+	now := time.Now()
+
+	defer unlockShards(t.s.lockKeys(keys))
+	for key, version := range t.reads {
+		cur, ok := t.s.lookup(key, now)
+		if !ok {
+			cur.version = 0
+		}
+		if cur.version != version {
+			return kv.ErrConflict
+		}
+	}
+
+	return t.s.apply(ops, now)
+}
+
+func (t *txn) Rollback() error {
+	t.closed = true
+	return nil
+}
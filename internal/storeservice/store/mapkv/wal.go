@@ -0,0 +1,358 @@
+package mapkv
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SyncPolicy controls when the write-ahead log is synced to disk.
+type SyncPolicy int
+
+const (
+	// SyncOnInterval syncs the log in background every SyncInterval, a
+	// crash of the machine loses at most the writes of one interval.
+	SyncOnInterval SyncPolicy = iota
+	// SyncAlways syncs the log before every write is acknowledged.
+	SyncAlways
+	// SyncNever leaves syncing to the operating system.
+	SyncNever
+)
+
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch s {
+	case "interval":
+		return SyncOnInterval, nil
+	case "always":
+		return SyncAlways, nil
+	case "never":
+		return SyncNever, nil
+	}
+	return 0, fmt.Errorf("unknown sync policy %q", s)
+}
+
+const (
+	walPrefix     = "wal-"
+	walSuffix     = ".log"
+	fileMode      = 0o644
+	recordHeader  = 8
+	maxRecordSize = 1 << 30
+)
+
+const (
+	changeSet    byte = 1
+	changeDelete byte = 2
+)
+
+var (
+	errTornRecord = errors.New("torn record")
+	errCorruptWAL = errors.New("corrupt write-ahead log")
+	errFailedWAL  = errors.New("write-ahead log failed")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// change is a single modification of the tree, a write is logged as a
+// record of changes sharing one version.
+type change struct {
+	deleted bool
+	entry   entry
+}
+
+// wal is a write-ahead log split in numbered segments. A record is the
+// payload length, the CRC of the payload and the payload itself.
+type wal struct {
+	dir    string
+	policy SyncPolicy
+
+	mu    sync.Mutex
+	seq   uint64
+	f     *os.File
+	size  int64
+	dirty bool
+	buf   []byte
+	// err is set when a failed write could not be undone, the log
+	// refuses every write after it.
+	err error
+}
+
+func walPath(dir string, seq uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%016d%s", walPrefix, seq, walSuffix))
+}
+
+func openWAL(dir string, seq uint64, policy SyncPolicy) (*wal, error) {
+	w := &wal{
+		dir:    dir,
+		policy: policy,
+	}
+	if err := w.open(seq); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *wal) open(seq uint64) error {
+	f, err := os.OpenFile(walPath(w.dir, seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, fileMode)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	w.seq, w.f, w.size = seq, f, info.Size()
+	return nil
+}
+
+func (w *wal) append(version uint64, changes []change) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+
+	w.buf = encodeRecord(w.buf[:0], version, changes)
+	if _, err := w.f.Write(w.buf); err != nil {
+		// Drop a partially written record, so it does not hide the
+		// records appended after it.
+		return w.undo(err)
+	}
+
+	if w.policy == SyncAlways {
+		if err := w.f.Sync(); err != nil {
+			// The write is not acknowledged, so it must not be
+			// replayed after a restart either.
+			return w.undo(err)
+		}
+	} else {
+		w.dirty = true
+	}
+	w.size += int64(len(w.buf))
+	return nil
+}
+
+// undo truncates the log back to its last acknowledged record after a
+// failed write. When that fails too the log is marked failed.
+func (w *wal) undo(err error) error {
+	if terr := w.f.Truncate(w.size); terr != nil {
+		w.err = fmt.Errorf("%w: %w", errFailedWAL, errors.Join(err, terr))
+		return w.err
+	}
+	return err
+}
+
+func (w *wal) sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.dirty {
+		return nil
+	}
+	w.dirty = false
+	return w.f.Sync()
+}
+
+// rotate starts a new segment and returns its number, the records of
+// the previous segments are all synced.
+func (w *wal) rotate() (uint64, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.f.Sync(); err != nil {
+		return 0, err
+	}
+	if err := w.f.Close(); err != nil {
+		return 0, err
+	}
+	w.dirty = false
+
+	if err := w.open(w.seq + 1); err != nil {
+		return 0, err
+	}
+	return w.seq, nil
+}
+
+func (w *wal) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.f.Sync(); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.f.Close()
+}
+
+func encodeRecord(buf []byte, version uint64, changes []change) []byte {
+	buf = append(buf, make([]byte, recordHeader)...)
+	buf = binary.AppendUvarint(buf, version)
+	buf = binary.AppendUvarint(buf, uint64(len(changes)))
+	for _, c := range changes {
+		if c.deleted {
+			buf = append(buf, changeDelete)
+			buf = appendBytes(buf, []byte(c.entry.key))
+			continue
+		}
+
+		var expiresAt int64
+		if !c.entry.expiresAt.IsZero() {
+			expiresAt = c.entry.expiresAt.UnixNano()
+		}
+		buf = append(buf, changeSet)
+		buf = appendBytes(buf, []byte(c.entry.key))
+		buf = appendBytes(buf, c.entry.value)
+		buf = binary.AppendVarint(buf, expiresAt)
+	}
+
+	payload := buf[recordHeader:]
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(payload, crcTable))
+	return buf
+}
+
+func appendBytes(buf, b []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// readRecord reads the next record of a segment. It returns io.EOF at
+// the end of the segment and errTornRecord when the record is
+// incomplete or does not match its checksum.
+func readRecord(r *bufio.Reader) (uint64, []change, int64, error) {
+	var header [recordHeader]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, nil, 0, errTornRecord
+		}
+		return 0, nil, 0, err
+	}
+
+	size := binary.LittleEndian.Uint32(header[0:4])
+	if size > maxRecordSize {
+		return 0, nil, 0, errTornRecord
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, 0, errTornRecord
+	}
+	if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+		return 0, nil, 0, errTornRecord
+	}
+
+	version, changes, err := decodeRecord(payload)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	return version, changes, int64(recordHeader + size), nil
+}
+
+func decodeRecord(payload []byte) (uint64, []change, error) {
+	d := decoder{buf: payload}
+
+	version := d.uvarint()
+	n := d.uvarint()
+	if d.err != nil || n > uint64(len(payload)) {
+		return 0, nil, errCorruptWAL
+	}
+
+	changes := make([]change, 0, n)
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		kind := d.byte()
+		c := change{entry: entry{key: string(d.bytes())}}
+		switch kind {
+		case changeDelete:
+			c.deleted = true
+		case changeSet:
+			c.entry.value = d.bytes()
+			if expiresAt := d.varint(); expiresAt != 0 {
+				c.entry.expiresAt = time.Unix(0, expiresAt)
+			}
+		default:
+			d.err = errCorruptWAL
+		}
+		changes = append(changes, c)
+	}
+	if d.err != nil {
+		return 0, nil, errCorruptWAL
+	}
+
+	return version, changes, nil
+}
+
+// decoder reads the fields of a record payload, the first error sticks.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil || len(d.buf) == 0 {
+		d.err = errCorruptWAL
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = errCorruptWAL
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errCorruptWAL
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil || n > uint64(len(d.buf)) {
+		d.err = errCorruptWAL
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, d.buf)
+	d.buf = d.buf[n:]
+	return b
+}
+
+// parseSeq returns the number of a file named prefix<seq>suffix.
+func parseSeq(name, prefix, suffix string) (uint64, bool) {
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+		return 0, false
+	}
+
+	seq, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, prefix), suffix), 10, 64)
+	return seq, err == nil
+}
@@ -0,0 +1,198 @@
+package mapkv
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvtests"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func openPersistent(tb testing.TB, dir string) *Store {
+	tb.Helper()
+	s, err := New(Config{Dir: dir, Sync: SyncNever}, Dependencies{Log: logrus.StandardLogger()})
+	require.NoError(tb, err)
+	return s.(*Store)
+}
+
+// crash stops the store the way a killed process would: the log is not
+// synced and no snapshot is taken.
+func crash(s *Store) {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+		s.wal.f.Close()
+	})
+}
+
+func requireValue(t *testing.T, s kv.Store, key, want string) {
+	t.Helper()
+	val, err := s.Get(context.Background(), kv.Key(key))
+	require.NoError(t, err, key)
+	require.Equal(t, kv.Value(want), val, key)
+}
+
+func requireNotFound(t *testing.T, s kv.Store, key string) {
+	t.Helper()
+	_, err := s.Get(context.Background(), kv.Key(key))
+	require.ErrorIs(t, err, kv.ErrNotFound, key)
+}
+
+func TestPersistentMapKV(t *testing.T) {
+	kvtests.RunTests(t, func(tb testing.TB) kv.Store {
+		return openPersistent(tb, tb.TempDir())
+	})
+}
+
+func FuzzPersistentMapKV(f *testing.F) {
+	kvtests.Fuzz(f, func(tb testing.TB) kv.Store {
+		return openPersistent(tb, tb.TempDir())
+	})
+}
+
+func TestCrashRecovery(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openPersistent(t, dir)
+	)
+
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("1")))
+	require.NoError(t, s.Set(ctx, kv.Key("b"), kv.Value("2")))
+	require.NoError(t, s.SetWithTTL(ctx, kv.Key("ttl"), kv.Value("3"), time.Hour))
+	require.NoError(t, s.Delete(ctx, kv.Key("b")))
+	require.NoError(t, s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("c"), Value: kv.Value("4")},
+		{Type: kv.OpDelete, Key: kv.Key("a")},
+	}))
+
+	txn, err := s.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, txn.Set(ctx, kv.Key("d"), kv.Value("5")))
+	require.NoError(t, txn.Commit(ctx))
+
+	_, version, err := s.GetWithVersion(ctx, kv.Key("d"))
+	require.NoError(t, err)
+	require.NoError(t, s.Delete(ctx, kv.Key("d")))
+	crash(s)
+
+	s = openPersistent(t, dir)
+	requireNotFound(t, s, "a")
+	requireNotFound(t, s, "b")
+	requireValue(t, s, "c", "4")
+	requireNotFound(t, s, "d")
+	requireValue(t, s, "ttl", "3")
+
+	// Versions keep growing, so versions seen before the crash do not
+	// match new writes.
+	require.NoError(t, s.Set(ctx, kv.Key("d"), kv.Value("6")))
+	_, newVersion, err := s.GetWithVersion(ctx, kv.Key("d"))
+	require.NoError(t, err)
+	require.Greater(t, newVersion, version+1)
+	require.NoError(t, s.Close())
+}
+
+func TestTornRecord(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openPersistent(t, dir)
+	)
+
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("1")))
+	require.NoError(t, s.Set(ctx, kv.Key("b"), kv.Value("2")))
+	path := walPath(dir, s.wal.seq)
+	crash(s)
+
+	// Cut the last record in the middle.
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-3))
+
+	s = openPersistent(t, dir)
+	requireValue(t, s, "a", "1")
+	requireNotFound(t, s, "b")
+
+	// New records follow the last complete one.
+	require.NoError(t, s.Set(ctx, kv.Key("c"), kv.Value("3")))
+	crash(s)
+
+	s = openPersistent(t, dir)
+	defer s.Close()
+	requireValue(t, s, "a", "1")
+	requireValue(t, s, "c", "3")
+}
+
+func TestSnapshotRecovery(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openPersistent(t, dir)
+	)
+
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("1")))
+	require.NoError(t, s.Set(ctx, kv.Key("b"), kv.Value("2")))
+	require.NoError(t, s.persistSnapshot())
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("3")))
+	require.NoError(t, s.Delete(ctx, kv.Key("b")))
+	require.NoError(t, s.persistSnapshot())
+	require.NoError(t, s.Set(ctx, kv.Key("c"), kv.Value("4")))
+	crash(s)
+
+	// Only the last snapshot and the log written after it are kept.
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{snapshotPath(dir, 2), walPath(dir, 2)}, files)
+
+	s = openPersistent(t, dir)
+	defer s.Close()
+	requireValue(t, s, "a", "3")
+	requireNotFound(t, s, "b")
+	requireValue(t, s, "c", "4")
+}
+
+func TestCorruptSegment(t *testing.T) {
+	var (
+		ctx = context.Background()
+		dir = t.TempDir()
+		s   = openPersistent(t, dir)
+	)
+
+	require.NoError(t, s.Set(ctx, kv.Key("a"), kv.Value("1")))
+	path := walPath(dir, s.wal.seq)
+	_, err := s.wal.rotate()
+	require.NoError(t, err)
+	require.NoError(t, s.Set(ctx, kv.Key("b"), kv.Value("2")))
+	crash(s)
+
+	// A damaged record which is not at the end of the log can not be
+	// a torn write.
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	_, err = New(Config{Dir: dir}, Dependencies{Log: logrus.StandardLogger()})
+	require.ErrorIs(t, err, errCorruptWAL)
+}
+
+func TestFailedWAL(t *testing.T) {
+	dir := t.TempDir()
+	w, err := openWAL(dir, 1, SyncAlways)
+	require.NoError(t, err)
+	require.NoError(t, w.append(1, []change{{entry: entry{key: "a", value: kv.Value("1")}}}))
+	size := w.size
+
+	// Neither the write nor the truncation can succeed on a closed
+	// file, so the log refuses every later write.
+	require.NoError(t, w.f.Close())
+	err = w.append(2, []change{{entry: entry{key: "b", value: kv.Value("2")}}})
+	require.ErrorIs(t, err, errFailedWAL)
+	err = w.append(3, []change{{entry: entry{key: "c", value: kv.Value("3")}}})
+	require.ErrorIs(t, err, errFailedWAL)
+	require.Equal(t, size, w.size)
+}
@@ -0,0 +1,53 @@
+package mapkv
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+)
+
+// Watch gets the events from commit, which publishes them in version
+// order, so a watcher can resume from the last version it got.
+func (s *Store) Watch(ctx context.Context, prefix kv.Key, fromVersion uint64, f kv.WatchHandler) error {
+	return s.hub.Watch(ctx, prefix, fromVersion, s.catchUp, f)
+}
+
+func (s *Store) catchUp(ctx context.Context, prefix kv.Key, fromVersion uint64, emit kv.WatchHandler) (uint64, error) {
+	trees, version := s.clone()
+
+	err := mergeTrees(trees, kv.ScanOptions{Prefix: prefix}, time.Now(), func(e entry) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if e.version <= fromVersion {
+			return nil
+		}
+		return emit(kv.Event{
+			Type:    kv.EventPut,
+			Key:     kv.Key(e.key),
+			Value:   e.value,
+			Version: e.version,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func changeEvents(version uint64, changes []change) []kv.Event {
+	events := make([]kv.Event, len(changes))
+	for i, c := range changes {
+		events[i] = kv.Event{
+			Type:    kv.EventPut,
+			Key:     kv.Key(c.entry.key),
+			Value:   c.entry.value,
+			Version: version,
+		}
+		if c.deleted {
+			events[i].Type = kv.EventDelete
+			events[i].Value = nil
+		}
+	}
+	return events
+}
@@ -0,0 +1,324 @@
+// Package metricskv wraps a kv.Store to record the metrics of its
+// operations.
+package metricskv
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"kvstore/internal/storeservice/opmetrics"
+	"kvstore/internal/storeservice/store/kv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Dependencies struct {
+	// Store is the wrapped store, it is closed with the wrapper.
+	Store kv.Store
+	// Registry gets the metrics, it is required.
+	Registry *prometheus.Registry
+}
+
+// Store passes every call to the wrapped store and records its latency
+// and error class, the sizes of the values it reads and writes and the
+// rows of its scans. The names of the metrics start with kv_.
+type Store struct {
+	deps    Dependencies
+	metrics *opmetrics.Metrics
+}
+
+func New(deps Dependencies) (*Store, error) {
+	s := &Store{
+		deps:    deps,
+		metrics: opmetrics.New("kv", nil),
+	}
+	if err := deps.Registry.Register(s.metrics); err != nil {
+		return nil, fmt.Errorf("register kv metrics: %w", err)
+	}
+	return s, nil
+}
+
+func (s *Store) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	s.metrics.ValueSize("set", len(v))
+	end := s.metrics.Start("set")
+	err := s.deps.Store.Set(ctx, k, v)
+	end(err)
+	return err
+}
+
+func (s *Store) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	s.metrics.ValueSize("set_with_ttl", len(v))
+	end := s.metrics.Start("set_with_ttl")
+	err := s.deps.Store.SetWithTTL(ctx, k, v, ttl)
+	end(err)
+	return err
+}
+
+func (s *Store) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	end := s.metrics.Start("get")
+	v, err := s.deps.Store.Get(ctx, k)
+	end(err)
+	if err == nil {
+		s.metrics.ValueSize("get", len(v))
+	}
+	return v, err
+}
+
+func (s *Store) GetWithVersion(ctx context.Context, k kv.Key) (kv.Value, uint64, error) {
+	end := s.metrics.Start("get_with_version")
+	v, version, err := s.deps.Store.GetWithVersion(ctx, k)
+	end(err)
+	if err == nil {
+		s.metrics.ValueSize("get_with_version", len(v))
+	}
+	return v, version, err
+}
+
+// GetWithExpiry reads the wrapped store if it is a kv.Expirer.
+func (s *Store) GetWithExpiry(ctx context.Context, k kv.Key) (kv.Value, uint64, time.Time, error) {
+	e, ok := s.deps.Store.(kv.Expirer)
+	if !ok {
+		return nil, 0, time.Time{}, kv.ErrExpiryUnsupported
+	}
+
+	end := s.metrics.Start("get_with_expiry")
+	v, version, expiresAt, err := e.GetWithExpiry(ctx, k)
+	end(err)
+	if err == nil {
+		s.metrics.ValueSize("get_with_expiry", len(v))
+	}
+	return v, version, expiresAt, err
+}
+
+func (s *Store) Delete(ctx context.Context, k kv.Key) error {
+	end := s.metrics.Start("delete")
+	err := s.deps.Store.Delete(ctx, k)
+	end(err)
+	return err
+}
+
+func (s *Store) DeletePrefix(ctx context.Context, prefix kv.Key) (int64, error) {
+	end := s.metrics.Start("delete_prefix")
+	n, err := s.deps.Store.DeletePrefix(ctx, prefix)
+	end(err)
+	return n, err
+}
+
+func (s *Store) DeleteRange(ctx context.Context, start, endKey kv.Key) (int64, error) {
+	end := s.metrics.Start("delete_range")
+	n, err := s.deps.Store.DeleteRange(ctx, start, endKey)
+	end(err)
+	return n, err
+}
+
+func (s *Store) SetIfVersion(ctx context.Context, k kv.Key, v kv.Value, version uint64) error {
+	s.metrics.ValueSize("set_if_version", len(v))
+	end := s.metrics.Start("set_if_version")
+	err := s.deps.Store.SetIfVersion(ctx, k, v, version)
+	end(err)
+	return err
+}
+
+func (s *Store) SetIfAbsent(ctx context.Context, k kv.Key, v kv.Value) error {
+	s.metrics.ValueSize("set_if_absent", len(v))
+	end := s.metrics.Start("set_if_absent")
+	err := s.deps.Store.SetIfAbsent(ctx, k, v)
+	end(err)
+	return err
+}
+
+func (s *Store) DeleteIfVersion(ctx context.Context, k kv.Key, version uint64) error {
+	end := s.metrics.Start("delete_if_version")
+	err := s.deps.Store.DeleteIfVersion(ctx, k, version)
+	end(err)
+	return err
+}
+
+func (s *Store) Scan(ctx context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
+	return scan(ctx, s.metrics, "scan", s.deps.Store.Scan, opts, f)
+}
+
+// scan runs the scan of a store or a snapshot counting its rows.
+func scan(ctx context.Context, m *opmetrics.Metrics, op string,
+	do func(context.Context, kv.ScanOptions, kv.ScanHandler) error, opts kv.ScanOptions, f kv.ScanHandler) error {
+	var rows int
+	end := m.Start(op)
+	err := do(ctx, opts, func(k kv.Key, v kv.Value) error {
+		rows++
+		return f(k, v)
+	})
+	end(err)
+	m.ScanRows(op, rows)
+	return err
+}
+
+func (s *Store) Write(ctx context.Context, ops []kv.Op) error {
+	for _, op := range ops {
+		if op.Type == kv.OpSet {
+			s.metrics.ValueSize("write", len(op.Value))
+		}
+	}
+	end := s.metrics.Start("write")
+	err := s.deps.Store.Write(ctx, ops)
+	end(err)
+	return err
+}
+
+func (s *Store) Begin(ctx context.Context) (kv.Txn, error) {
+	end := s.metrics.Start("begin")
+	t, err := s.deps.Store.Begin(ctx)
+	end(err)
+	if err != nil {
+		return nil, err
+	}
+	return &txn{txn: t, metrics: s.metrics}, nil
+}
+
+func (s *Store) Snapshot(ctx context.Context) (kv.Snapshot, error) {
+	end := s.metrics.Start("snapshot")
+	snap, err := s.deps.Store.Snapshot(ctx)
+	end(err)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot{snap: snap, metrics: s.metrics}, nil
+}
+
+// Watch counts the errors of watches only, their duration is up to the
+// watcher. A watch ended by its context is not an error.
+func (s *Store) Watch(ctx context.Context, prefix kv.Key, fromVersion uint64, f kv.WatchHandler) error {
+	err := s.deps.Store.Watch(ctx, prefix, fromVersion, f)
+	if ctx.Err() == nil {
+		s.metrics.Error("watch", err)
+	}
+	return err
+}
+
+func (s *Store) Count(ctx context.Context, prefix kv.Key) (int64, error) {
+	end := s.metrics.Start("count")
+	n, err := s.deps.Store.Count(ctx, prefix)
+	end(err)
+	return n, err
+}
+
+func (s *Store) CountRange(ctx context.Context, start, endKey kv.Key) (int64, error) {
+	end := s.metrics.Start("count_range")
+	n, err := s.deps.Store.CountRange(ctx, start, endKey)
+	end(err)
+	return n, err
+}
+
+func (s *Store) Stats(ctx context.Context) (kv.Stats, error) {
+	end := s.metrics.Start("stats")
+	stats, err := s.deps.Store.Stats(ctx)
+	end(err)
+	return stats, err
+}
+
+func (s *Store) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	end := s.metrics.Start("backup")
+	version, err := s.deps.Store.Backup(ctx, w, since)
+	end(err)
+	return version, err
+}
+
+func (s *Store) Restore(ctx context.Context, r io.Reader) error {
+	end := s.metrics.Start("restore")
+	err := s.deps.Store.Restore(ctx, r)
+	end(err)
+	return err
+}
+
+// Compact compacts the wrapped store if it supports it.
+func (s *Store) Compact(ctx context.Context) (int64, error) {
+	c, ok := s.deps.Store.(kv.Compactor)
+	if !ok {
+		return 0, kv.ErrCompactUnsupported
+	}
+
+	end := s.metrics.Start("compact")
+	n, err := c.Compact(ctx)
+	end(err)
+	return n, err
+}
+
+func (s *Store) Close() error {
+	s.deps.Registry.Unregister(s.metrics)
+	return s.deps.Store.Close()
+}
+
+type txn struct {
+	txn     kv.Txn
+	metrics *opmetrics.Metrics
+}
+
+func (t *txn) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	end := t.metrics.Start("txn_get")
+	v, err := t.txn.Get(ctx, k)
+	end(err)
+	if err == nil {
+		t.metrics.ValueSize("txn_get", len(v))
+	}
+	return v, err
+}
+
+func (t *txn) Set(ctx context.Context, k kv.Key, v kv.Value) error {
+	t.metrics.ValueSize("txn_set", len(v))
+	end := t.metrics.Start("txn_set")
+	err := t.txn.Set(ctx, k, v)
+	end(err)
+	return err
+}
+
+func (t *txn) SetWithTTL(ctx context.Context, k kv.Key, v kv.Value, ttl time.Duration) error {
+	t.metrics.ValueSize("txn_set_with_ttl", len(v))
+	end := t.metrics.Start("txn_set_with_ttl")
+	err := t.txn.SetWithTTL(ctx, k, v, ttl)
+	end(err)
+	return err
+}
+
+func (t *txn) Delete(ctx context.Context, k kv.Key) error {
+	end := t.metrics.Start("txn_delete")
+	err := t.txn.Delete(ctx, k)
+	end(err)
+	return err
+}
+
+func (t *txn) Commit(ctx context.Context) error {
+	end := t.metrics.Start("txn_commit")
+	err := t.txn.Commit(ctx)
+	end(err)
+	return err
+}
+
+func (t *txn) Rollback() error {
+	end := t.metrics.Start("txn_rollback")
+	err := t.txn.Rollback()
+	end(err)
+	return err
+}
+
+type snapshot struct {
+	snap    kv.Snapshot
+	metrics *opmetrics.Metrics
+}
+
+func (s *snapshot) Get(ctx context.Context, k kv.Key) (kv.Value, error) {
+	end := s.metrics.Start("snapshot_get")
+	v, err := s.snap.Get(ctx, k)
+	end(err)
+	if err == nil {
+		s.metrics.ValueSize("snapshot_get", len(v))
+	}
+	return v, err
+}
+
+func (s *snapshot) Scan(ctx context.Context, opts kv.ScanOptions, f kv.ScanHandler) error {
+	return scan(ctx, s.metrics, "snapshot_scan", s.snap.Scan, opts, f)
+}
+
+func (s *snapshot) Close() error {
+	return s.snap.Close()
+}
@@ -0,0 +1,89 @@
+package metricskv
+
+import (
+	"context"
+	"errors"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvtests"
+	"kvstore/internal/storeservice/store/mapkv"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newInstrumented(tb testing.TB, reg *prometheus.Registry) *Store {
+	tb.Helper()
+	s, err := New(Dependencies{Store: mapkv.NewStore(), Registry: reg})
+	require.NoError(tb, err)
+	return s
+}
+
+func TestMetricsKV(t *testing.T) {
+	kvtests.RunTests(t, func(tb testing.TB) kv.Store {
+		return newInstrumented(tb, prometheus.NewRegistry())
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	ctx := context.Background()
+	reg := prometheus.NewRegistry()
+	s := newInstrumented(t, reg)
+
+	require.NoError(t, s.Set(ctx, kv.Key("m/a"), kv.Value("12345")))
+	require.NoError(t, s.Write(ctx, []kv.Op{
+		{Type: kv.OpSet, Key: kv.Key("m/b"), Value: kv.Value("1")},
+		{Type: kv.OpDelete, Key: kv.Key("m/c")},
+	}))
+	_, err := s.Get(ctx, kv.Key("m/a"))
+	require.NoError(t, err)
+	_, err = s.Get(ctx, kv.Key("m/missing"))
+	require.ErrorIs(t, err, kv.ErrNotFound)
+	require.ErrorIs(t, s.SetIfVersion(ctx, kv.Key("m/a"), kv.Value("x"), 12345), kv.ErrConflict)
+	stop := errors.New("stop")
+	err = s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key("m/")}, func(kv.Key, kv.Value) error { return stop })
+	require.ErrorIs(t, err, stop)
+	require.NoError(t, s.Scan(ctx, kv.ScanOptions{Prefix: kv.Key("m/")}, func(kv.Key, kv.Value) error { return nil }))
+
+	// A watch ended by its context is not an error.
+	watchCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_ = s.Watch(watchCtx, nil, 0, func(kv.Event) error { return nil })
+
+	expected := `
+# HELP kv_operation_errors_total Failed operations by error class.
+# TYPE kv_operation_errors_total counter
+kv_operation_errors_total{class="conflict",op="set_if_version"} 1
+kv_operation_errors_total{class="internal",op="scan"} 1
+kv_operation_errors_total{class="not_found",op="get"} 1
+# HELP kv_scan_rows Rows returned by a scan.
+# TYPE kv_scan_rows histogram
+kv_scan_rows_bucket{op="scan",le="1"} 1
+kv_scan_rows_bucket{op="scan",le="4"} 2
+kv_scan_rows_bucket{op="scan",le="16"} 2
+kv_scan_rows_bucket{op="scan",le="64"} 2
+kv_scan_rows_bucket{op="scan",le="256"} 2
+kv_scan_rows_bucket{op="scan",le="1024"} 2
+kv_scan_rows_bucket{op="scan",le="4096"} 2
+kv_scan_rows_bucket{op="scan",le="16384"} 2
+kv_scan_rows_bucket{op="scan",le="65536"} 2
+kv_scan_rows_bucket{op="scan",le="262144"} 2
+kv_scan_rows_bucket{op="scan",le="+Inf"} 2
+kv_scan_rows_sum{op="scan"} 3
+kv_scan_rows_count{op="scan"} 2
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "kv_operation_errors_total", "kv_scan_rows"))
+
+	// The values of sets and successful gets are measured.
+	count, err := testutil.GatherAndCount(reg, "kv_operation_duration_seconds")
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+	require.Equal(t, 4, testutil.CollectAndCount(s.metrics, "kv_value_size_bytes"))
+
+	// The metrics are unregistered on close.
+	require.NoError(t, s.Close())
+	s = newInstrumented(t, reg)
+	require.NoError(t, s.Close())
+}
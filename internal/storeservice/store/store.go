@@ -0,0 +1,161 @@
+// Package store is the registry of kv.Store backends. Backends register
+// themselves from init, so a binary offers the backends it imports.
+package store
+
+import (
+	"fmt"
+	"kvstore/internal/storeservice/store/kv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// Option describes a backend specific setting.
+type Option struct {
+	Name    string
+	Usage   string
+	Default string
+}
+
+// Options holds the values of the options of a backend by name.
+type Options map[string]string
+
+func (o Options) String(name string) string {
+	return o[name]
+}
+
+func (o Options) Bool(name string) (bool, error) {
+	if o[name] == "" {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(o[name])
+	if err != nil {
+		return false, fmt.Errorf("option %s: %w", name, err)
+	}
+	return v, nil
+}
+
+func (o Options) Int64(name string) (int64, error) {
+	if o[name] == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(o[name], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("option %s: %w", name, err)
+	}
+	return v, nil
+}
+
+func (o Options) Float64(name string) (float64, error) {
+	if o[name] == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(o[name], 64)
+	if err != nil {
+		return 0, fmt.Errorf("option %s: %w", name, err)
+	}
+	return v, nil
+}
+
+func (o Options) Duration(name string) (time.Duration, error) {
+	if o[name] == "" {
+		return 0, nil
+	}
+	v, err := time.ParseDuration(o[name])
+	if err != nil {
+		return 0, fmt.Errorf("option %s: %w", name, err)
+	}
+	return v, nil
+}
+
+type Dependencies struct {
+	Log *logrus.Logger
+	// Registry gets the metrics of the backend, nil leaves them out.
+	Registry *prometheus.Registry
+}
+
+type Backend struct {
+	Name    string
+	Usage   string
+	Options []Option
+	New     func(Options, Dependencies) (kv.Store, error)
+	// TestOptions returns the options the backend is run with by the
+	// conformance tests, dir is an empty temporary directory.
+	TestOptions func(dir string) Options
+}
+
+var (
+	mu       sync.RWMutex
+	backends = make(map[string]Backend)
+)
+
+// Register makes the backend available by its name. It panics when the
+// name is already taken.
+func Register(b Backend) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := backends[b.Name]; ok {
+		panic("store: backend registered twice: " + b.Name)
+	}
+	backends[b.Name] = b
+}
+
+// Backends returns the registered backends sorted by name.
+func Backends() []Backend {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	ret := make([]Backend, 0, len(backends))
+	for _, b := range backends {
+		ret = append(ret, b)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
+
+type Config struct {
+	Backend string
+	// Options of the backend, missing options take their defaults.
+	Options Options
+}
+
+// New opens the configured backend.
+func New(cfg Config, deps Dependencies) (kv.Store, error) {
+	mu.RLock()
+	b, ok := backends[cfg.Backend]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown backend %q, available: %s", cfg.Backend, strings.Join(names(), ", "))
+	}
+
+	opts := make(Options, len(b.Options))
+	for _, o := range b.Options {
+		opts[o.Name] = o.Default
+	}
+	for name, value := range cfg.Options {
+		if _, ok := opts[name]; !ok {
+			return nil, fmt.Errorf("backend %s has no option %q", b.Name, name)
+		}
+		opts[name] = value
+	}
+
+	s, err := b.New(opts, deps)
+	if err != nil {
+		return nil, fmt.Errorf("backend %s: %w", b.Name, err)
+	}
+	return s, nil
+}
+
+func names() []string {
+	var ret []string
+	for _, b := range Backends() {
+		ret = append(ret, b.Name)
+	}
+	return ret
+}
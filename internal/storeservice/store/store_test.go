@@ -0,0 +1,55 @@
+package store_test
+
+import (
+	"kvstore/internal/storeservice/store"
+	_ "kvstore/internal/storeservice/store/badgerkv"
+	_ "kvstore/internal/storeservice/store/bitcask"
+	"kvstore/internal/storeservice/store/kv"
+	"kvstore/internal/storeservice/store/kvtests"
+	_ "kvstore/internal/storeservice/store/mapkv"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackends(t *testing.T) {
+	backends := store.Backends()
+	require.NotEmpty(t, backends)
+
+	for _, b := range backends {
+		t.Run(b.Name, func(t *testing.T) {
+			kvtests.RunTests(t, func(tb testing.TB) kv.Store {
+				s, err := store.New(store.Config{
+					Backend: b.Name,
+					Options: b.TestOptions(tb.TempDir()),
+				}, store.Dependencies{Log: logrus.StandardLogger()})
+				require.NoError(tb, err)
+				return s
+			})
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	deps := store.Dependencies{Log: logrus.StandardLogger()}
+
+	_, err := store.New(store.Config{Backend: "unknown"}, deps)
+	require.ErrorContains(t, err, "unknown backend")
+
+	_, err = store.New(store.Config{
+		Backend: "map",
+		Options: store.Options{"no-such-option": "1"},
+	}, deps)
+	require.ErrorContains(t, err, "no option")
+
+	_, err = store.New(store.Config{
+		Backend: "map",
+		Options: store.Options{"sync": "sometimes"},
+	}, deps)
+	require.ErrorContains(t, err, "sync policy")
+
+	s, err := store.New(store.Config{Backend: "map"}, deps)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+}
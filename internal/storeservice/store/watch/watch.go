@@ -0,0 +1,156 @@
+// Package watch fans out the changes of a store to its watchers, so the
+// backends implement kv.Store.Watch by publishing their writes.
+package watch
+
+import (
+	"bytes"
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"sync"
+	"sync/atomic"
+)
+
+// bufferSize is how many events a watcher may fall behind before it is
+// stopped with kv.ErrWatchLagged.
+const bufferSize = 1024
+
+type Hub struct {
+	mu       sync.RWMutex
+	watchers map[*watcher]struct{}
+	closed   bool
+	// count lets writers skip building events nobody watches.
+	count atomic.Int32
+}
+
+type watcher struct {
+	prefix kv.Key
+	events chan kv.Event
+
+	done     chan struct{}
+	err      error
+	stopOnce sync.Once
+}
+
+func (w *watcher) stop(err error) {
+	w.stopOnce.Do(func() {
+		w.err = err
+		close(w.done)
+	})
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		watchers: make(map[*watcher]struct{}),
+	}
+}
+
+// Watching reports whether anybody watches the changes.
+func (h *Hub) Watching() bool {
+	return h.count.Load() > 0
+}
+
+// Publish hands the events to the watchers of their keys. It never
+// blocks: a watcher which has no room for an event is stopped.
+func (h *Hub) Publish(events ...kv.Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for w := range h.watchers {
+		for _, e := range events {
+			if !bytes.HasPrefix(e.Key, w.prefix) {
+				continue
+			}
+			select {
+			case w.events <- e:
+			default:
+				w.stop(kv.ErrWatchLagged)
+			}
+		}
+	}
+}
+
+// Close stops every watcher with kv.ErrClosed, later watches fail with
+// it right away.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for w := range h.watchers {
+		w.stop(kv.ErrClosed)
+	}
+}
+
+// CatchUpFunc emits the current entries with the prefix written after
+// fromVersion and returns the version of the state it read. It stops
+// with ctx.Err() once ctx is done.
+type CatchUpFunc func(ctx context.Context, prefix kv.Key, fromVersion uint64, emit kv.WatchHandler) (uint64, error)
+
+// Watch implements kv.Store.Watch. The watcher is registered before
+// catchUp reads the state, events up to the version of that state are
+// already covered by it and are skipped.
+func (h *Hub) Watch(ctx context.Context, prefix kv.Key, fromVersion uint64, catchUp CatchUpFunc, f kv.WatchHandler) error {
+	w, err := h.register(prefix)
+	if err != nil {
+		return err
+	}
+	defer h.unregister(w)
+
+	seen := fromVersion
+	if fromVersion > 0 {
+		version, err := catchUp(ctx, prefix, fromVersion, func(e kv.Event) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return f(e)
+		})
+		if err != nil {
+			return err
+		}
+		seen = max(seen, version)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.done:
+			return w.err
+		case e := <-w.events:
+			if e.Version <= seen {
+				continue
+			}
+			if err := f(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *Hub) register(prefix kv.Key) (*watcher, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, kv.ErrClosed
+	}
+
+	w := &watcher{
+		prefix: prefix,
+		events: make(chan kv.Event, bufferSize),
+		done:   make(chan struct{}),
+	}
+	h.watchers[w] = struct{}{}
+	h.count.Add(1)
+	return w, nil
+}
+
+func (h *Hub) unregister(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		h.count.Add(-1)
+	}
+}
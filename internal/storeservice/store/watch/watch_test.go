@@ -0,0 +1,103 @@
+package watch
+
+import (
+	"context"
+	"kvstore/internal/storeservice/store/kv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func noCatchUp(context.Context, kv.Key, uint64, kv.WatchHandler) (uint64, error) {
+	return 0, nil
+}
+
+// startWatch runs Watch in background and waits until it is registered.
+func startWatch(t *testing.T, h *Hub, prefix string, f kv.WatchHandler) <-chan error {
+	t.Helper()
+	done := make(chan error, 1)
+	go func() {
+		done <- h.Watch(context.Background(), kv.Key(prefix), 0, noCatchUp, f)
+	}()
+	require.Eventually(t, h.Watching, time.Second, time.Millisecond)
+	return done
+}
+
+func TestPrefix(t *testing.T) {
+	var (
+		h      = NewHub()
+		events = make(chan kv.Event, 10)
+	)
+	done := startWatch(t, h, "a/", func(e kv.Event) error {
+		events <- e
+		return nil
+	})
+
+	h.Publish(
+		kv.Event{Key: kv.Key("b/1"), Version: 1},
+		kv.Event{Key: kv.Key("a/1"), Version: 1},
+	)
+	h.Publish(kv.Event{Type: kv.EventDelete, Key: kv.Key("a/1"), Version: 2})
+
+	require.Equal(t, kv.Key("a/1"), (<-events).Key)
+	e := <-events
+	require.Equal(t, kv.EventDelete, e.Type)
+	require.Equal(t, uint64(2), e.Version)
+
+	h.Close()
+	require.ErrorIs(t, <-done, kv.ErrClosed)
+	require.False(t, h.Watching())
+	require.Empty(t, events)
+
+	err := h.Watch(context.Background(), nil, 0, noCatchUp, func(kv.Event) error { return nil })
+	require.ErrorIs(t, err, kv.ErrClosed)
+}
+
+func TestLagged(t *testing.T) {
+	var (
+		h       = NewHub()
+		blocked = make(chan struct{})
+	)
+	defer h.Close()
+
+	done := startWatch(t, h, "", func(kv.Event) error {
+		<-blocked
+		return nil
+	})
+
+	// Publishing never waits for the watcher.
+	for i := 0; i < bufferSize+2; i++ {
+		h.Publish(kv.Event{Key: kv.Key("key"), Version: uint64(i + 1)})
+	}
+	close(blocked)
+	require.ErrorIs(t, <-done, kv.ErrWatchLagged)
+}
+
+func TestCatchUp(t *testing.T) {
+	var (
+		h      = NewHub()
+		events = make(chan kv.Event, 10)
+		ctx    = context.Background()
+	)
+	defer h.Close()
+
+	catchUp := func(_ context.Context, prefix kv.Key, from uint64, emit kv.WatchHandler) (uint64, error) {
+		require.Equal(t, uint64(3), from)
+		// Writes made while the state is read are published too.
+		h.Publish(
+			kv.Event{Key: kv.Key("a"), Version: 4},
+			kv.Event{Key: kv.Key("b"), Version: 6},
+		)
+		return 5, emit(kv.Event{Key: kv.Key("a"), Version: 4})
+	}
+	go h.Watch(ctx, nil, 3, catchUp, func(e kv.Event) error {
+		events <- e
+		return nil
+	})
+
+	// The event already covered by the state is skipped.
+	require.Equal(t, uint64(4), (<-events).Version)
+	require.Equal(t, uint64(6), (<-events).Version)
+	require.Empty(t, events)
+}